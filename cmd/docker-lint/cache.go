@@ -0,0 +1,74 @@
+// file: cmd/docker-lint/cache.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/asymmetric-effort/docker-lint/internal/cache"
+	"github.com/asymmetric-effort/docker-lint/internal/config"
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+	"github.com/asymmetric-effort/docker-lint/internal/version"
+)
+
+// cacheUsageText describes the command line usage for the cache subcommand.
+const cacheUsageText = "usage: docker-lint cache clean"
+
+// runCache manages the on-disk result cache.
+//
+// `cache clean` removes every cached finding and prints the directory it cleaned.
+func runCache(args []string, out io.Writer) error {
+	if len(args) != 1 {
+		return errors.New(cacheUsageText)
+	}
+	switch args[0] {
+	case "-h", "--help":
+		fmt.Fprintln(out, cacheUsageText)
+		return nil
+	case "clean":
+		dir, err := cache.Dir()
+		if err != nil {
+			return err
+		}
+		if err := cache.New(dir).Clean(); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "removed %s\n", dir)
+		return nil
+	default:
+		return fmt.Errorf("unknown cache command %q", args[0])
+	}
+}
+
+// cacheSalt digests the inputs besides file content that determine findings:
+// the linter version, the effective configuration, the registered rules, the
+// fingerprints of plugin executables and the target stage.
+func cacheSalt(cfg *config.Config, reg *engine.Registry, target string) string {
+	c, _ := json.Marshal(cfg)
+	var prints []string
+	for _, r := range reg.Rules() {
+		if f, ok := r.(interface{ Fingerprint() string }); ok {
+			prints = append(prints, r.ID()+"="+f.Fingerprint())
+		}
+	}
+	return cache.Key([]byte(version.Current), c, []byte(strings.Join(reg.IDs(), ",")), []byte(strings.Join(prints, ",")), []byte(target))
+}
+
+// contextKey digests the parts of a build context that rules inspect: the
+// context directory and the location and patterns of its .dockerignore file.
+func contextKey(c *ir.Context) []byte {
+	var b strings.Builder
+	b.WriteString(c.Dir)
+	if c.Ignore != nil {
+		b.WriteString("\x00" + c.Ignore.Path)
+		for _, p := range c.Ignore.Patterns {
+			b.WriteString("\x00" + p.Text)
+		}
+	}
+	return []byte(b.String())
+}
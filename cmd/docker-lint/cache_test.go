@@ -0,0 +1,124 @@
+// file: cmd/docker-lint/cache_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/asymmetric-effort/docker-lint/internal/cache"
+	"github.com/asymmetric-effort/docker-lint/internal/dockerignore"
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// TestContextKey verifies that the .dockerignore patterns are part of the cache key.
+func TestContextKey(t *testing.T) {
+	none := contextKey(&ir.Context{Dir: "app"})
+	git := contextKey(&ir.Context{Dir: "app", Ignore: dockerignore.Parse("app/.dockerignore", []byte(".git\n"))})
+	env := contextKey(&ir.Context{Dir: "app", Ignore: dockerignore.Parse("app/.dockerignore", []byte(".env\n"))})
+	if bytes.Equal(none, git) || bytes.Equal(git, env) {
+		t.Fatalf("expected distinct keys")
+	}
+}
+
+// TestIntegrationRunCacheReplay verifies that cached findings are replayed and --no-cache bypasses them.
+func TestIntegrationRunCacheReplay(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	df := filepath.Join(t.TempDir(), "Dockerfile")
+	if err := os.WriteFile(df, []byte("FROM alpine:latest\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	var first bytes.Buffer
+	if err := run([]string{df}, &first, io.Discard, false); err != nil {
+		t.Fatalf("run failed: %v", err)
+	}
+	l := &linter{reg: engine.NewRegistry()}
+	if err := registerRules(context.Background(), l.reg, nil, engine.Selection{}); err != nil {
+		t.Fatal(err)
+	}
+	key := cache.Key([]byte(cacheSalt(nil, l.reg, "")), []byte("FROM alpine:latest\n"), contextKey(&ir.Context{Dir: filepath.Dir(df)}))
+	dir, err := cache.Dir()
+	if err != nil {
+		t.Fatalf("cache dir: %v", err)
+	}
+	c := cache.New(dir)
+	if _, ok := c.Get(key); !ok {
+		t.Fatalf("expected findings to be cached")
+	}
+	if err := c.Put(key, []engine.Finding{{RuleID: "CACHED", Message: "replayed", Line: 1}}); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	var replay bytes.Buffer
+	if err := run([]string{df}, &replay, io.Discard, false); err != nil {
+		t.Fatalf("run failed: %v", err)
+	}
+	if !strings.Contains(replay.String(), "CACHED") {
+		t.Fatalf("expected cached findings, got %s", replay.String())
+	}
+	var fresh bytes.Buffer
+	if err := run([]string{"--no-cache", df}, &fresh, io.Discard, false); err != nil {
+		t.Fatalf("run failed: %v", err)
+	}
+	if fresh.String() != first.String() {
+		t.Fatalf("expected --no-cache to relint, got %s", fresh.String())
+	}
+}
+
+// TestIntegrationRunCacheInvalidatedByContent verifies that edited files are relinted.
+func TestIntegrationRunCacheInvalidatedByContent(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	df := filepath.Join(t.TempDir(), "Dockerfile")
+	for _, tc := range []struct {
+		src  string
+		want int
+	}{{"FROM alpine:latest\n", 2}, {"FROM alpine:3.19\n", 0}} {
+		if err := os.WriteFile(df, []byte(tc.src), 0o644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+		var out bytes.Buffer
+		if err := run([]string{df}, &out, io.Discard, false); err != nil {
+			t.Fatalf("run failed: %v", err)
+		}
+		var findings []engine.Finding
+		if err := json.Unmarshal(out.Bytes(), &findings); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		if len(findings) != tc.want {
+			t.Fatalf("%q: expected %d findings, got %v", tc.src, tc.want, findings)
+		}
+	}
+}
+
+// TestRunCacheClean verifies that cache clean removes the cache directory.
+func TestRunCacheClean(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	dir, err := cache.Dir()
+	if err != nil {
+		t.Fatalf("cache dir: %v", err)
+	}
+	if err := cache.New(dir).Put("abc", nil); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	var out bytes.Buffer
+	if err := run([]string{"cache", "clean"}, &out, io.Discard, false); err != nil {
+		t.Fatalf("cache clean failed: %v", err)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Fatalf("expected cache directory removed, got %v", err)
+	}
+	if !strings.Contains(out.String(), dir) {
+		t.Fatalf("expected cleaned directory in output, got %q", out.String())
+	}
+	for _, args := range [][]string{{"cache"}, {"cache", "purge"}} {
+		if err := run(args, io.Discard, io.Discard, false); err == nil {
+			t.Fatalf("%v: expected error", args)
+		}
+	}
+}
@@ -5,6 +5,7 @@ package main
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"strings"
 	"testing"
 
@@ -67,3 +68,23 @@ func TestPrintErrorNoColor(t *testing.T) {
 		t.Fatalf("unexpected color codes in output: %q", errBuf.String())
 	}
 }
+
+// TestRunColoredHyperlinks verifies that rule IDs link to their documentation when color is enabled.
+func TestRunColoredHyperlinks(t *testing.T) {
+	df := testDataPath("Dockerfile.bad")
+	var errBuf bytes.Buffer
+	if err := run([]string{df}, io.Discard, &errBuf, true); err != nil {
+		t.Fatalf("run failed: %v", err)
+	}
+	want := hyperlink("https://github.com/asymmetric-effort/docker-lint/blob/main/docs/rules/DL3007.md", "DL3007")
+	if !strings.Contains(errBuf.String(), want) {
+		t.Fatalf("expected hyperlink, got %q", errBuf.String())
+	}
+	errBuf.Reset()
+	if err := run([]string{df}, io.Discard, &errBuf, false); err != nil {
+		t.Fatalf("run failed: %v", err)
+	}
+	if strings.Contains(errBuf.String(), "\033]8;;") {
+		t.Fatalf("unexpected hyperlink without color: %q", errBuf.String())
+	}
+}
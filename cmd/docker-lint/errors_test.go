@@ -51,17 +51,52 @@ func TestRunInvalidDefaultConfig(t *testing.T) {
 	}
 }
 
+// TestRunTargetFlagMissingValue verifies that an error is returned when --target lacks a value.
+func TestRunTargetFlagMissingValue(t *testing.T) {
+	var out bytes.Buffer
+	err := run([]string{"--target"}, &out, io.Discard, false)
+	if err == nil || !strings.Contains(err.Error(), "missing stage name") {
+		t.Fatalf("expected missing stage error, got %v", err)
+	}
+}
+
+// TestRunTargetNotFound verifies that an unknown target stage causes an error.
+func TestRunTargetNotFound(t *testing.T) {
+	df := testDataPath("Dockerfile.good")
+	var out bytes.Buffer
+	err := run([]string{"--target", "nope", df}, &out, io.Discard, false)
+	if err == nil || !strings.Contains(err.Error(), `target stage "nope" not found`) {
+		t.Fatalf("expected target error, got %v", err)
+	}
+}
+
+// TestRunContextFlagErrors verifies that --context requires an existing directory.
+func TestRunContextFlagErrors(t *testing.T) {
+	df := testDataPath("Dockerfile.good")
+	cases := map[string][]string{
+		"missing directory":  {"--context"},
+		"no such file":       {"--context", filepath.Join(t.TempDir(), "missing"), df},
+		"is not a directory": {"--context", df, df},
+	}
+	for want, args := range cases {
+		err := run(args, io.Discard, io.Discard, false)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("%v: expected %q error, got %v", args, want, err)
+		}
+	}
+}
+
 // TestExpandPathsInvalidPattern verifies that invalid glob patterns return an error.
 func TestExpandPathsInvalidPattern(t *testing.T) {
-	if _, err := expandPaths([]string{"["}); err == nil {
+	if _, err := expandPaths([]string{"["}, nil); err == nil {
 		t.Fatalf("expected glob error")
 	}
 }
 
 // TestLintFileOpenError verifies that lintFile reports errors when files cannot be opened.
 func TestLintFileOpenError(t *testing.T) {
-	reg := engine.NewRegistry()
-	if _, err := lintFile(context.Background(), reg, "does-not-exist"); err == nil {
+	l := &linter{reg: engine.NewRegistry()}
+	if _, err := l.lintFile(context.Background(), "does-not-exist"); err == nil {
 		t.Fatalf("expected open error")
 	}
 }
@@ -81,3 +116,49 @@ func TestMainExitOnError(t *testing.T) {
 		t.Fatalf("expected exit code 1, got %v", err)
 	}
 }
+
+// TestRunJobsFlagErrors verifies validation of the --jobs and --rule-timeout values.
+func TestRunJobsFlagErrors(t *testing.T) {
+	cases := map[string][]string{
+		"missing job count":    {"-j"},
+		"invalid job count":    {"--jobs", "0", "Dockerfile"},
+		"missing duration":     {"--rule-timeout"},
+		"invalid rule timeout": {"--rule-timeout", "soon", "Dockerfile"},
+	}
+	for want, args := range cases {
+		err := run(args, io.Discard, io.Discard, false)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("%v: expected %q error, got %v", args, want, err)
+		}
+	}
+}
+
+// TestLintFilesReportsFirstFailureInOrder verifies that the earliest failing file determines the error.
+func TestLintFilesReportsFirstFailureInOrder(t *testing.T) {
+	tmp := t.TempDir()
+	files := []string{testDataPath("Dockerfile.good"), filepath.Join(tmp, "missing1"), filepath.Join(tmp, "missing2")}
+	l := &linter{reg: engine.NewRegistry()}
+	_, err := l.lintFiles(context.Background(), files, 3)
+	if err == nil || !strings.Contains(err.Error(), "missing1") {
+		t.Fatalf("expected error for first missing file, got %v", err)
+	}
+}
+
+// TestLintFilesCancelled verifies that a cancelled context stops linting.
+func TestLintFilesCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	l := &linter{reg: engine.NewRegistry()}
+	_, err := l.lintFiles(ctx, []string{testDataPath("Dockerfile.good")}, 1)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+// TestRunExcludeFlagMissingValue verifies that an error is returned when --exclude lacks a value.
+func TestRunExcludeFlagMissingValue(t *testing.T) {
+	err := run([]string{"--exclude"}, io.Discard, io.Discard, false)
+	if err == nil || !strings.Contains(err.Error(), "missing glob") {
+		t.Fatalf("expected missing glob error, got %v", err)
+	}
+}
@@ -0,0 +1,64 @@
+// file: cmd/docker-lint/graph.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/asymmetric-effort/docker-lint/internal/graph"
+)
+
+// graphUsageText describes the command line usage for the graph subcommand.
+const graphUsageText = "usage: docker-lint graph [--format dot|mermaid] [--target stage] <Dockerfile>"
+
+// runGraph writes the stage dependency graph of each Dockerfile in args to out.
+//
+// The graph is emitted as Graphviz DOT unless --format selects Mermaid.
+func runGraph(args []string, out io.Writer) error {
+	var (
+		files  []string
+		format = graph.FormatDOT
+		target string
+	)
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		switch a {
+		case "-h", "--help":
+			fmt.Fprintln(out, graphUsageText)
+			return nil
+		case "-f", "--format":
+			if i+1 >= len(args) {
+				return fmt.Errorf("missing format after %s", a)
+			}
+			format = graph.Format(args[i+1])
+			i++
+		case "--target":
+			if i+1 >= len(args) {
+				return fmt.Errorf("missing stage name after %s", a)
+			}
+			target = args[i+1]
+			i++
+		default:
+			files = append(files, a)
+		}
+	}
+	if len(files) == 0 {
+		return errors.New(graphUsageText)
+	}
+	files, err := expandPaths(files, nil)
+	if err != nil {
+		return err
+	}
+	for _, path := range files {
+		doc, err := loadDocument(path, target)
+		if err != nil {
+			return err
+		}
+		if err := graph.Write(out, doc, format); err != nil {
+			return err
+		}
+	}
+	return nil
+}
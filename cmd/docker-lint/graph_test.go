@@ -0,0 +1,74 @@
+// file: cmd/docker-lint/graph_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeGraphDockerfile writes a two-stage Dockerfile into a temp directory.
+func writeGraphDockerfile(t *testing.T) string {
+	t.Helper()
+	df := filepath.Join(t.TempDir(), "Dockerfile")
+	src := "FROM golang:1.22 AS build\nRUN go build\nFROM alpine:3.19\nCOPY --from=build /out /out\n"
+	if err := os.WriteFile(df, []byte(src), 0o644); err != nil {
+		t.Fatalf("write dockerfile: %v", err)
+	}
+	return df
+}
+
+// TestIntegrationRunGraphDOT verifies the graph subcommand emits DOT by default.
+func TestIntegrationRunGraphDOT(t *testing.T) {
+	var out bytes.Buffer
+	if err := run([]string{"graph", writeGraphDockerfile(t)}, &out, io.Discard, false); err != nil {
+		t.Fatalf("run graph: %v", err)
+	}
+	if !strings.HasPrefix(out.String(), "digraph ") || !strings.Contains(out.String(), `"s0" -> "s1" [label="COPY --from"];`) {
+		t.Fatalf("unexpected DOT output:\n%s", out.String())
+	}
+}
+
+// TestIntegrationRunGraphMermaid verifies --format mermaid output.
+func TestIntegrationRunGraphMermaid(t *testing.T) {
+	var out bytes.Buffer
+	if err := run([]string{"graph", "--format", "mermaid", writeGraphDockerfile(t)}, &out, io.Discard, false); err != nil {
+		t.Fatalf("run graph: %v", err)
+	}
+	if !strings.HasPrefix(out.String(), "flowchart LR") || !strings.Contains(out.String(), "s0 -->|COPY --from| s1") {
+		t.Fatalf("unexpected Mermaid output:\n%s", out.String())
+	}
+}
+
+// TestRunGraphErrors covers argument and format errors for the graph subcommand.
+func TestRunGraphErrors(t *testing.T) {
+	df := writeGraphDockerfile(t)
+	cases := map[string][]string{
+		"usage":                {"graph"},
+		"missing format":       {"graph", "--format"},
+		"missing stage name":   {"graph", "--target"},
+		"unknown graph format": {"graph", "--format", "svg", df},
+		"not found":            {"graph", "--target", "nope", df},
+	}
+	for want, args := range cases {
+		err := run(args, io.Discard, io.Discard, false)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("%v: expected error containing %q, got %v", args, want, err)
+		}
+	}
+}
+
+// TestRunGraphHelp verifies the graph subcommand prints its usage.
+func TestRunGraphHelp(t *testing.T) {
+	var out bytes.Buffer
+	if err := run([]string{"graph", "--help"}, &out, io.Discard, false); err != nil {
+		t.Fatalf("run graph: %v", err)
+	}
+	if !strings.Contains(out.String(), "docker-lint graph") {
+		t.Fatalf("expected graph usage, got %q", out.String())
+	}
+}
@@ -3,26 +3,40 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	doublestar "github.com/bmatcuk/doublestar/v4"
 	"github.com/moby/buildkit/frontend/dockerfile/parser"
 	"github.com/sam-caldwell/ansi"
 
+	"github.com/asymmetric-effort/docker-lint/internal/cache"
 	"github.com/asymmetric-effort/docker-lint/internal/config"
+	"github.com/asymmetric-effort/docker-lint/internal/discover"
 	"github.com/asymmetric-effort/docker-lint/internal/engine"
 	"github.com/asymmetric-effort/docker-lint/internal/ir"
+	"github.com/asymmetric-effort/docker-lint/internal/plugin"
 	"github.com/asymmetric-effort/docker-lint/internal/rules"
 	"github.com/asymmetric-effort/docker-lint/internal/version"
 )
 
 // usageText describes the command line usage for the application.
-const usageText = "usage: docker-lint [--version] [-c file] <Dockerfile>"
+const usageText = "usage: docker-lint [--version] [-c file] [--format json|sarif] [--target stage] [--context dir] [-j jobs] [--parallel-rules] [--rule-timeout duration] [--no-cache] [--exclude glob] [--enable rules] [--only rules] [--disable rules] [--enable-category c] [--disable-category c] [--watch] <Dockerfile|dir>\n" +
+	"       docker-lint graph [--format dot|mermaid] [--target stage] <Dockerfile>\n" +
+	"       docker-lint rules [-c file] [--format text|json] [--all] [--enable rules] [--only rules] [--disable rules] [--enable-category c] [--disable-category c]\n" +
+	"       docker-lint cache clean"
 
 // printUsage writes the CLI usage information to the provided writer.
 func printUsage(out io.Writer) {
@@ -46,14 +60,40 @@ func main() {
 	}
 }
 
-// run executes the linter for the provided arguments and writes JSON findings.
+// run executes the linter for the provided arguments and writes JSON findings,
+// or a SARIF 2.1.0 log with --format sarif.
 //
 // In addition to the JSON output, run emits a human-readable summary to errOut.
-// When color is true, the summary uses ANSI colors. If args contain a version flag, run prints the application version to out and exits.
+// When color is true, the summary uses ANSI colors and links rule IDs to their
+// documentation. If args contain a version flag, run prints the application version to out and exits.
+// The graph, rules and cache subcommands are dispatched to runGraph, runRules and runCache.
+// Findings for unchanged files are replayed from the result cache unless
+// --no-cache or --context is given; with --context, findings depend on files
+// in the build context that the cache key does not cover.
 func run(args []string, out io.Writer, errOut io.Writer, color bool) error {
+	if len(args) > 0 {
+		switch args[0] {
+		case "graph":
+			return runGraph(args[1:], out)
+		case "rules":
+			return runRules(args[1:], out)
+		case "cache":
+			return runCache(args[1:], out)
+		}
+	}
 	var (
-		files      []string
-		configPath string
+		files         []string
+		configPath    string
+		target        string
+		contextDir    string
+		jobs          = runtime.GOMAXPROCS(0)
+		parallelRules bool
+		ruleTimeout   time.Duration
+		noCache       bool
+		exclude       []string
+		watchMode     bool
+		format        = "json"
+		sel           engine.Selection
 	)
 	for i := 0; i < len(args); i++ {
 		a := args[i]
@@ -70,6 +110,67 @@ func run(args []string, out io.Writer, errOut io.Writer, color bool) error {
 			}
 			configPath = args[i+1]
 			i++
+		case "-f", "--format":
+			if i+1 >= len(args) {
+				return fmt.Errorf("missing format after %s", a)
+			}
+			format = args[i+1]
+			if format != "json" && format != "sarif" {
+				return fmt.Errorf("unknown format %q", format)
+			}
+			i++
+		case "--target":
+			if i+1 >= len(args) {
+				return fmt.Errorf("missing stage name after %s", a)
+			}
+			target = args[i+1]
+			i++
+		case "--context":
+			if i+1 >= len(args) {
+				return fmt.Errorf("missing directory after %s", a)
+			}
+			contextDir = args[i+1]
+			i++
+		case "--enable", "--only", "--disable", "--enable-category", "--disable-category":
+			if i+1 >= len(args) {
+				return fmt.Errorf("missing value after %s", a)
+			}
+			if err := parseSelection(&sel, a, args[i+1]); err != nil {
+				return err
+			}
+			i++
+		case "-j", "--jobs":
+			if i+1 >= len(args) {
+				return fmt.Errorf("missing job count after %s", a)
+			}
+			n, err := strconv.Atoi(args[i+1])
+			if err != nil || n < 1 {
+				return fmt.Errorf("invalid job count %q", args[i+1])
+			}
+			jobs = n
+			i++
+		case "--parallel-rules":
+			parallelRules = true
+		case "--no-cache":
+			noCache = true
+		case "--watch":
+			watchMode = true
+		case "--exclude":
+			if i+1 >= len(args) {
+				return fmt.Errorf("missing glob after %s", a)
+			}
+			exclude = append(exclude, args[i+1])
+			i++
+		case "--rule-timeout":
+			if i+1 >= len(args) {
+				return fmt.Errorf("missing duration after %s", a)
+			}
+			d, err := time.ParseDuration(args[i+1])
+			if err != nil || d < 0 {
+				return fmt.Errorf("invalid rule timeout %q", args[i+1])
+			}
+			ruleTimeout = d
+			i++
 		default:
 			files = append(files, a)
 		}
@@ -77,84 +178,139 @@ func run(args []string, out io.Writer, errOut io.Writer, color bool) error {
 	if len(files) == 0 {
 		return errors.New(usageText)
 	}
-
-	var cfg *config.Config
-	if configPath != "" {
-		c, err := config.Load(configPath)
-		if err != nil {
-			return err
-		}
-		cfg = c
-	} else {
-		if _, err := os.Stat(".docker-lint.yaml"); err == nil {
-			c, err := config.Load(".docker-lint.yaml")
-			if err != nil {
-				return err
-			}
-			cfg = c
-		} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+	if contextDir != "" {
+		if fi, err := os.Stat(contextDir); err != nil {
 			return err
+		} else if !fi.IsDir() {
+			return fmt.Errorf("build context %s is not a directory", contextDir)
 		}
 	}
 
-	files, err := expandPaths(files)
+	cfg, err := loadConfig(configPath)
 	if err != nil {
 		return err
 	}
+	sel = rules.Selection(cfg).Merge(sel)
 
-	reg := engine.NewRegistry()
-	var ignored []string
-	if cfg != nil {
-		ignored = cfg.Ignored
+	opts := []engine.Option{engine.WithRuleTimeout(ruleTimeout)}
+	if parallelRules {
+		opts = append(opts, engine.WithRuleConcurrency(runtime.GOMAXPROCS(0)))
+	}
+	reg := engine.NewRegistry(opts...)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if err := registerRules(ctx, reg, cfg, sel); err != nil {
+		return err
 	}
-	registerRules(reg, ignored)
 
-	ctx := context.Background()
-	var all []engine.Finding
-	for _, path := range files {
-		fnds, err := lintFile(ctx, reg, path)
-		if err != nil {
-			return err
-		}
-		for _, f := range fnds {
-			if cfg != nil && cfg.IsIgnored(f.RuleID) {
-				continue
-			}
-			all = append(all, f)
+	l := &linter{reg: reg, sel: sel, target: target, contextDir: contextDir, docs: docsURLs(reg.Rules())}
+	if !noCache && contextDir == "" {
+		if dir, err := cache.Dir(); err == nil {
+			l.cache = cache.New(dir)
+			l.salt = cacheSalt(cfg, reg, target)
 		}
 	}
-	if err := json.NewEncoder(out).Encode(all); err != nil {
+	if watchMode {
+		return l.watch(ctx, files, exclude, jobs, errOut, color)
+	}
+	files, err = expandPaths(files, exclude)
+	if err != nil {
+		return err
+	}
+	results, err := l.lintFiles(ctx, files, jobs)
+	if err != nil {
+		return err
+	}
+	var all []engine.Finding
+	for _, fnds := range results {
+		all = append(all, fnds...)
+	}
+	if format == "sarif" {
+		err = writeSARIF(out, reg.Rules(), files, results)
+	} else {
+		err = json.NewEncoder(out).Encode(all)
+	}
+	if err != nil {
 		return err
 	}
-	printFindings(errOut, all, color)
+	printFindings(errOut, all, color, l.docs)
 	return nil
 }
 
-// registerRules adds built-in rules to reg, skipping any whose IDs appear in ignored.
-func registerRules(reg *engine.Registry, ignored []string) {
-	skip := map[string]struct{}{}
-	for _, id := range ignored {
-		skip[id] = struct{}{}
+// loadConfig loads the configuration at path or, when path is empty, the
+// .docker-lint.yaml file in the working directory if one exists.
+func loadConfig(path string) (*config.Config, error) {
+	if path != "" {
+		return config.Load(path)
 	}
-	all := []engine.Rule{
-		rules.NewNoLatestTag(),
-		rules.NewRequireOSVersionTag(),
-		rules.NewAptNoInstallRecommends(),
-		rules.NewAptPin(),
-		rules.NewAptListsCleanup(),
-		rules.NewDnfNoUpgrade(),
-		rules.NewDnfCacheCleanup(),
+	if _, err := os.Stat(".docker-lint.yaml"); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
 	}
-	for _, r := range all {
-		if _, ok := skip[r.ID()]; ok {
-			continue
+	return config.Load(".docker-lint.yaml")
+}
+
+// parseSelection adds the comma-separated rule ID patterns or categories in
+// arg to sel, according to the selection flag that preceded it.
+func parseSelection(sel *engine.Selection, flag, arg string) error {
+	for _, v := range strings.Split(arg, ",") {
+		v = strings.TrimSpace(v)
+		switch flag {
+		case "--enable":
+			sel.Enable = append(sel.Enable, v)
+		case "--only":
+			sel.Only = append(sel.Only, v)
+		case "--disable":
+			sel.Disable = append(sel.Disable, v)
+		default:
+			c, ok := engine.ParseCategory(v)
+			if !ok {
+				return fmt.Errorf("unknown category %q", v)
+			}
+			if flag == "--enable-category" {
+				sel.Categories = append(sel.Categories, c)
+			} else {
+				sel.ExcludeCategories = append(sel.ExcludeCategories, c)
+			}
 		}
+	}
+	return sel.Validate()
+}
+
+// registerRules adds the rules of cfg that sel selects to reg.
+func registerRules(ctx context.Context, reg *engine.Registry, cfg *config.Config, sel engine.Selection) error {
+	all, err := rules.Load(ctx, cfg, sel)
+	if err != nil {
+		return err
+	}
+	for _, r := range all {
 		reg.Register(r)
 	}
+	return nil
+}
+
+// docsURLs maps the ID of each rule with documentation to its docs URL.
+func docsURLs(rs []engine.Rule) map[string]string {
+	docs := map[string]string{}
+	for _, r := range rs {
+		if u := engine.Describe(r).DocsURL; u != "" {
+			docs[r.ID()] = u
+		}
+	}
+	return docs
+}
+
+// hyperlink wraps text in an OSC 8 terminal hyperlink to url.
+func hyperlink(url, text string) string {
+	return "\033]8;;" + url + "\033\\" + text + "\033]8;;\033\\"
 }
 
 // printFindings writes a human-readable summary of findings to errOut.
-func printFindings(errOut io.Writer, fnds []engine.Finding, color bool) {
+//
+// With color, rule IDs found in docs are rendered as hyperlinks to their documentation.
+func printFindings(errOut io.Writer, fnds []engine.Finding, color bool, docs map[string]string) {
 	if len(fnds) == 0 {
 		if color {
 			fmt.Fprintf(errOut, "%sNo issues found%s\n", ansi.CodeFgGreen, ansi.CodeReset)
@@ -165,7 +321,11 @@ func printFindings(errOut io.Writer, fnds []engine.Finding, color bool) {
 	}
 	for _, f := range fnds {
 		if color {
-			fmt.Fprintf(errOut, "%s%s (rule: %s, line: %d)%s\n", ansi.CodeFgYellow, f.Message, f.RuleID, f.Line, ansi.CodeReset)
+			id := f.RuleID
+			if u, ok := docs[id]; ok {
+				id = hyperlink(u, id)
+			}
+			fmt.Fprintf(errOut, "%s%s (rule: %s, line: %d)%s\n", ansi.CodeFgYellow, f.Message, id, f.Line, ansi.CodeReset)
 		} else {
 			fmt.Fprintf(errOut, "%s (rule: %s, line: %d)\n", f.Message, f.RuleID, f.Line)
 		}
@@ -181,8 +341,11 @@ func printError(errOut io.Writer, color bool, err error) {
 	}
 }
 
-// expandPaths resolves glob patterns into file paths.
-func expandPaths(patterns []string) ([]string, error) {
+// expandPaths resolves glob patterns and directories into file paths.
+//
+// Directories, whether named directly or matched by a glob, are walked
+// recursively for Dockerfiles. Paths matching an exclude glob are dropped.
+func expandPaths(patterns, exclude []string) ([]string, error) {
 	var files []string
 	for _, p := range patterns {
 		matches, err := doublestar.FilepathGlob(p)
@@ -193,13 +356,145 @@ func expandPaths(patterns []string) ([]string, error) {
 			files = append(files, p)
 			continue
 		}
-		files = append(files, matches...)
+		for _, m := range matches {
+			if fi, err := os.Stat(m); err == nil && fi.IsDir() {
+				found, err := discover.Walk(m, exclude)
+				if err != nil {
+					return nil, err
+				}
+				files = append(files, found...)
+				continue
+			}
+			if discover.Excluded(m, exclude) {
+				continue
+			}
+			files = append(files, m)
+		}
 	}
 	return files, nil
 }
 
+// linter lints Dockerfiles with a shared rule registry and optional result cache.
+type linter struct {
+	reg *engine.Registry
+	// sel drops findings of disabled rules.
+	sel    engine.Selection
+	target string
+	// contextDir is the build context given with --context; empty stands for
+	// the directory of each Dockerfile.
+	contextDir string
+	// cache replays findings for unchanged files; nil disables caching.
+	cache *cache.Cache
+	// salt digests every input other than file content that affects findings.
+	salt string
+	// docs maps rule IDs to documentation URLs for hyperlinks in summaries.
+	docs map[string]string
+}
+
+// lintFiles lints files on up to jobs concurrent workers.
+//
+// Findings are returned per file in the order of files, regardless of which
+// worker finishes first. The first failure in file order is returned; once a
+// file fails, later files are skipped while earlier ones still finish so the
+// reported error does not depend on scheduling.
+func (l *linter) lintFiles(ctx context.Context, files []string, jobs int) ([][]engine.Finding, error) {
+	results := make([][]engine.Finding, len(files))
+	errs := make([]error, len(files))
+	var (
+		mu     sync.Mutex
+		failed = len(files)
+		wg     sync.WaitGroup
+	)
+	next := make(chan int)
+	for w := 0; w < min(jobs, len(files)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				mu.Lock()
+				skip := i > failed
+				mu.Unlock()
+				if skip {
+					continue
+				}
+				if errs[i] = ctx.Err(); errs[i] == nil {
+					results[i], errs[i] = l.lintFile(ctx, files[i])
+				}
+				if errs[i] != nil {
+					mu.Lock()
+					failed = min(failed, i)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for i := range files {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
 // lintFile lints a single Dockerfile and returns any findings.
-func lintFile(ctx context.Context, reg *engine.Registry, path string) (fnds []engine.Finding, err error) {
+//
+// When target is non-empty, the named stage is linted as the build target
+// instead of the final stage. With a cache, findings for previously linted
+// content are replayed without parsing, and fresh findings are stored on a
+// best-effort basis. Findings for disabled rules are dropped.
+func (l *linter) lintFile(ctx context.Context, path string) ([]engine.Finding, error) {
+	fnds, err := l.check(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	var kept []engine.Finding
+	for _, f := range fnds {
+		if !l.sel.Disabled(f.RuleID) {
+			kept = append(kept, f)
+		}
+	}
+	return kept, nil
+}
+
+// check returns every finding for path, consulting the cache when enabled.
+func (l *linter) check(ctx context.Context, path string) ([]engine.Finding, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := ir.LoadContext(path, l.contextDir)
+	if err != nil {
+		return nil, err
+	}
+	var key string
+	if l.cache != nil {
+		key = cache.Key([]byte(l.salt), src, contextKey(bc))
+		if fnds, ok := l.cache.Get(key); ok {
+			return fnds, nil
+		}
+	}
+	doc, err := parseDocument(path, bytes.NewReader(src), l.target)
+	if err != nil {
+		return nil, err
+	}
+	doc.Context = bc
+	fnds, err := l.reg.Run(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+	if l.cache != nil && !slices.ContainsFunc(fnds, plugin.IsFailure) {
+		_ = l.cache.Put(key, fnds)
+	}
+	return fnds, nil
+}
+
+// loadDocument parses a Dockerfile and builds its Document, selecting target when non-empty.
+func loadDocument(path, target string) (doc *ir.Document, err error) {
 	f, err := os.Open(path)
 	if err != nil {
 		return nil, err
@@ -209,7 +504,12 @@ func lintFile(ctx context.Context, reg *engine.Registry, path string) (fnds []en
 			err = cerr
 		}
 	}()
-	res, err := parser.Parse(f)
+	return parseDocument(path, f, target)
+}
+
+// parseDocument parses Dockerfile source read from r, selecting target when non-empty.
+func parseDocument(path string, r io.Reader, target string) (*ir.Document, error) {
+	res, err := parser.Parse(r)
 	if err != nil {
 		return nil, err
 	}
@@ -217,5 +517,10 @@ func lintFile(ctx context.Context, reg *engine.Registry, path string) (fnds []en
 	if err != nil {
 		return nil, err
 	}
-	return reg.Run(ctx, doc)
+	if target != "" {
+		if err := doc.SetTarget(target); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return doc, nil
 }
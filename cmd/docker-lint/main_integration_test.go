@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"io/fs"
 	"os"
@@ -28,8 +29,8 @@ func TestIntegrationRunDetectsLatest(t *testing.T) {
 	if err := json.Unmarshal(out.Bytes(), &findings); err != nil {
 		t.Fatalf("unmarshal: %v", err)
 	}
-	if len(findings) != 2 {
-		t.Fatalf("expected 2 findings, got %d", len(findings))
+	if len(findings) != 3 {
+		t.Fatalf("expected 3 findings, got %d", len(findings))
 	}
 	ids := map[string]struct{}{}
 	for _, f := range findings {
@@ -140,8 +141,8 @@ func TestIntegrationRunGlob(t *testing.T) {
 	if err := json.Unmarshal(out.Bytes(), &findings); err != nil {
 		t.Fatalf("unmarshal: %v", err)
 	}
-	if len(findings) != 2 {
-		t.Fatalf("expected 2 findings, got %d", len(findings))
+	if len(findings) != 3 {
+		t.Fatalf("expected 3 findings, got %d", len(findings))
 	}
 }
 
@@ -177,8 +178,8 @@ func TestIntegrationRunDoubleStar(t *testing.T) {
 	if err := json.Unmarshal(out.Bytes(), &findings); err != nil {
 		t.Fatalf("unmarshal: %v", err)
 	}
-	if len(findings) != 4 {
-		t.Fatalf("expected 4 findings, got %d", len(findings))
+	if len(findings) != 6 {
+		t.Fatalf("expected 6 findings, got %d", len(findings))
 	}
 }
 
@@ -209,11 +210,11 @@ func TestIntegrationRunDefaultConfigIgnored(t *testing.T) {
 	if err := json.Unmarshal(out.Bytes(), &findings); err != nil {
 		t.Fatalf("unmarshal: %v", err)
 	}
-	if len(findings) != 1 {
-		t.Fatalf("expected 1 finding, got %d", len(findings))
+	if len(findings) != 2 {
+		t.Fatalf("expected 2 findings, got %d", len(findings))
 	}
-	if findings[0].RuleID != rules.NewRequireOSVersionTag().ID() {
-		t.Fatalf("unexpected rule: %s", findings[0].RuleID)
+	if findings[0].RuleID != rules.NewRequireTag().ID() || findings[1].RuleID != rules.NewRequireOSVersionTag().ID() {
+		t.Fatalf("unexpected rules: %s, %s", findings[0].RuleID, findings[1].RuleID)
 	}
 }
 
@@ -242,11 +243,11 @@ func TestIntegrationRunConfigFlagShort(t *testing.T) {
 	if err := json.Unmarshal(out.Bytes(), &findings); err != nil {
 		t.Fatalf("unmarshal: %v", err)
 	}
-	if len(findings) != 1 {
-		t.Fatalf("expected 1 finding, got %d", len(findings))
+	if len(findings) != 2 {
+		t.Fatalf("expected 2 findings, got %d", len(findings))
 	}
-	if findings[0].RuleID != rules.NewRequireOSVersionTag().ID() {
-		t.Fatalf("unexpected rule: %s", findings[0].RuleID)
+	if findings[0].RuleID != rules.NewRequireTag().ID() || findings[1].RuleID != rules.NewRequireOSVersionTag().ID() {
+		t.Fatalf("unexpected rules: %s, %s", findings[0].RuleID, findings[1].RuleID)
 	}
 }
 
@@ -275,11 +276,11 @@ func TestIntegrationRunConfigFlagLong(t *testing.T) {
 	if err := json.Unmarshal(out.Bytes(), &findings); err != nil {
 		t.Fatalf("unmarshal: %v", err)
 	}
-	if len(findings) != 1 {
-		t.Fatalf("expected 1 finding, got %d", len(findings))
+	if len(findings) != 2 {
+		t.Fatalf("expected 2 findings, got %d", len(findings))
 	}
-	if findings[0].RuleID != rules.NewRequireOSVersionTag().ID() {
-		t.Fatalf("unexpected rule: %s", findings[0].RuleID)
+	if findings[0].RuleID != rules.NewRequireTag().ID() || findings[1].RuleID != rules.NewRequireOSVersionTag().ID() {
+		t.Fatalf("unexpected rules: %s, %s", findings[0].RuleID, findings[1].RuleID)
 	}
 }
 
@@ -318,3 +319,181 @@ func TestIntegrationRunVersionFlagLong(t *testing.T) {
 		t.Fatalf("expected %q, got %q", version.Current, got)
 	}
 }
+
+// TestIntegrationRunTarget verifies that --target changes which stages are considered dead.
+func TestIntegrationRunTarget(t *testing.T) {
+	tmp := t.TempDir()
+	df := filepath.Join(tmp, "Dockerfile")
+	src := "FROM alpine:3.19 AS test\nRUN echo test\nFROM alpine:3.19 AS release\nRUN echo release\n"
+	if err := os.WriteFile(df, []byte(src), 0o644); err != nil {
+		t.Fatalf("write dockerfile: %v", err)
+	}
+	for target, line := range map[string]int{"": 1, "test": 3} {
+		args := []string{df}
+		if target != "" {
+			args = append([]string{"--target", target}, args...)
+		}
+		var out bytes.Buffer
+		if err := run(args, &out, io.Discard, false); err != nil {
+			t.Fatalf("run: %v", err)
+		}
+		var findings []engine.Finding
+		if err := json.Unmarshal(out.Bytes(), &findings); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		if len(findings) != 1 || findings[0].RuleID != rules.NewUnreachableStage().ID() || findings[0].Line != line {
+			t.Fatalf("target %q: expected DL3062 on line %d, got %#v", target, line, findings)
+		}
+	}
+}
+
+// TestIntegrationRunContext verifies that --context checks COPY sources against the given directory.
+func TestIntegrationRunContext(t *testing.T) {
+	tmp := t.TempDir()
+	df := filepath.Join(tmp, "build", "Dockerfile")
+	if err := os.MkdirAll(filepath.Dir(df), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(df, []byte("FROM alpine:3.19\nCOPY app.conf /etc/app.conf\n"), 0o644); err != nil {
+		t.Fatalf("write dockerfile: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmp, "app.conf"), nil, 0o644); err != nil {
+		t.Fatalf("write source: %v", err)
+	}
+	for dir, want := range map[string]int{tmp: 0, filepath.Dir(df): 1} {
+		var out bytes.Buffer
+		if err := run([]string{"--context", dir, df}, &out, io.Discard, false); err != nil {
+			t.Fatalf("run: %v", err)
+		}
+		var findings []engine.Finding
+		if err := json.Unmarshal(out.Bytes(), &findings); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		got := 0
+		for _, f := range findings {
+			if f.RuleID == rules.NewSourceInContext().ID() {
+				got++
+			}
+		}
+		if got != want {
+			t.Fatalf("context %s: expected %d DL3088 findings, got %#v", dir, want, findings)
+		}
+	}
+}
+
+// TestIntegrationRunJobsDeterministic verifies that parallel linting preserves file order.
+func TestIntegrationRunJobsDeterministic(t *testing.T) {
+	tmp := t.TempDir()
+	var files []string
+	for i := 0; i < 16; i++ {
+		src := fmt.Sprintf("FROM alpine:%d\nFROM alpine\n", i)
+		if i%2 == 0 {
+			src = "FROM alpine:3.19\n"
+		}
+		p := filepath.Join(tmp, fmt.Sprintf("Dockerfile.%02d", i))
+		if err := os.WriteFile(p, []byte(src), 0o644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+		files = append(files, p)
+	}
+	var want bytes.Buffer
+	if err := run(append([]string{"-j", "1"}, files...), &want, io.Discard, false); err != nil {
+		t.Fatalf("sequential run failed: %v", err)
+	}
+	for i := 0; i < 5; i++ {
+		var got bytes.Buffer
+		if err := run(append([]string{"--jobs", "8", "--parallel-rules", "--rule-timeout", "10s"}, files...), &got, io.Discard, false); err != nil {
+			t.Fatalf("parallel run failed: %v", err)
+		}
+		if got.String() != want.String() {
+			t.Fatalf("parallel output differs:\n%s\nwant:\n%s", got.String(), want.String())
+		}
+	}
+}
+
+// TestIntegrationRunDirectory verifies that directories are scanned for Dockerfiles honoring --exclude.
+func TestIntegrationRunDirectory(t *testing.T) {
+	tmp := t.TempDir()
+	files := map[string]string{
+		"app/Dockerfile":              "FROM alpine:latest\n",
+		"app/worker.Dockerfile":       "FROM alpine:3.19\n",
+		"app/node_modules/Dockerfile": "FROM alpine:latest\n",
+		"examples/Containerfile":      "FROM alpine:latest\n",
+		"notes.txt":                   "FROM alpine:latest\n",
+	}
+	for name, src := range files {
+		p := filepath.Join(tmp, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(p, []byte(src), 0o644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	for args, want := range map[string]int{"": 4, "examples": 2} {
+		cmd := []string{tmp}
+		if args != "" {
+			cmd = []string{"--exclude", args, tmp}
+		}
+		var out bytes.Buffer
+		if err := run(cmd, &out, io.Discard, false); err != nil {
+			t.Fatalf("run failed: %v", err)
+		}
+		var findings []engine.Finding
+		if err := json.Unmarshal(out.Bytes(), &findings); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		if len(findings) != want {
+			t.Fatalf("exclude %q: expected %d findings, got %v", args, want, findings)
+		}
+	}
+}
+
+// TestIntegrationRunCustomRules verifies that custom-rules declared in the config are reported with their severity.
+func TestIntegrationRunCustomRules(t *testing.T) {
+	tmp := t.TempDir()
+	df := filepath.Join(tmp, "Dockerfile")
+	if err := os.WriteFile(df, []byte("FROM alpine:3.20\nRUN curl -fsSL https://example.com/install.sh | bash\n"), 0o644); err != nil {
+		t.Fatalf("write dockerfile: %v", err)
+	}
+	cfgPath := filepath.Join(tmp, "cfg.yaml")
+	cfg := []byte("custom-rules:\n" +
+		"  - id: ACME001\n" +
+		"    severity: error\n" +
+		"    message: Do not pipe downloads into a shell\n" +
+		"    instruction: RUN\n" +
+		"    match:\n" +
+		"      regex: 'curl[^|]*\\|\\s*(ba)?sh'\n")
+	if err := os.WriteFile(cfgPath, cfg, 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	var out bytes.Buffer
+	if err := run([]string{"-c", cfgPath, "--only", "ACME*", df}, &out, io.Discard, false); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	var findings []engine.Finding
+	if err := json.Unmarshal(out.Bytes(), &findings); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(findings) != 1 || findings[0].RuleID != "ACME001" || findings[0].Severity != "error" || findings[0].Line != 2 {
+		t.Fatalf("unexpected findings: %+v", findings)
+	}
+	if err := os.WriteFile(cfgPath, []byte("custom-rules:\n  - id: ACME001\n"), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	if err := run([]string{"-c", cfgPath, df}, io.Discard, io.Discard, false); err == nil || !strings.Contains(err.Error(), "missing message") {
+		t.Fatalf("expected invalid custom rule error, got %v", err)
+	}
+	if err := os.WriteFile(cfgPath, []byte("custom-rules:\n  - id: DL3008\n    message: m\n"), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	if err := run([]string{"-c", cfgPath, df}, io.Discard, io.Discard, false); err == nil || !strings.Contains(err.Error(), "duplicate rule DL3008") {
+		t.Fatalf("expected duplicate rule error, got %v", err)
+	}
+	if err := os.WriteFile(cfgPath, []byte("denied-ports:\n  - 2375/tpc\n"), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	if err := run([]string{"-c", cfgPath, df}, io.Discard, io.Discard, false); err == nil || !strings.Contains(err.Error(), `denied-ports: "2375/tpc"`) {
+		t.Fatalf("expected invalid port error, got %v", err)
+	}
+}
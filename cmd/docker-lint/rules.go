@@ -0,0 +1,111 @@
+// file: cmd/docker-lint/rules.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/rules"
+)
+
+// rulesUsageText describes the command line usage for the rules subcommand.
+const rulesUsageText = "usage: docker-lint rules [-c file] [--format text|json] [--all] [--enable rules] [--only rules] [--disable rules] [--enable-category c] [--disable-category c]"
+
+// ruleListing is the JSON form of a listed rule.
+type ruleListing struct {
+	ID string `json:"id"`
+	engine.Metadata
+}
+
+// runRules lists the rules a lint run would apply, with their metadata.
+//
+// The listing honors the configuration and rule selection exactly as a lint
+// run does, so custom rules, policies and plugin rules are included. --all
+// lists every available rule instead, opt-in and disabled ones included. It
+// is a table unless --format selects JSON.
+func runRules(args []string, out io.Writer) error {
+	var (
+		configPath string
+		format     = "text"
+		all        bool
+		sel        engine.Selection
+	)
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		switch a {
+		case "-h", "--help":
+			fmt.Fprintln(out, rulesUsageText)
+			return nil
+		case "-c", "--config":
+			if i+1 >= len(args) {
+				return fmt.Errorf("missing config file after %s", a)
+			}
+			configPath = args[i+1]
+			i++
+		case "-f", "--format":
+			if i+1 >= len(args) {
+				return fmt.Errorf("missing format after %s", a)
+			}
+			format = args[i+1]
+			if format != "text" && format != "json" {
+				return fmt.Errorf("unknown format %q", format)
+			}
+			i++
+		case "--all":
+			all = true
+		case "--enable", "--only", "--disable", "--enable-category", "--disable-category":
+			if i+1 >= len(args) {
+				return fmt.Errorf("missing value after %s", a)
+			}
+			if err := parseSelection(&sel, a, args[i+1]); err != nil {
+				return err
+			}
+			i++
+		default:
+			return fmt.Errorf("unexpected argument %q\n%s", a, rulesUsageText)
+		}
+	}
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+	var rs []engine.Rule
+	if all {
+		rs, err = rules.All(context.Background(), cfg)
+	} else {
+		rs, err = rules.Load(context.Background(), cfg, rules.Selection(cfg).Merge(sel))
+	}
+	if err != nil {
+		return err
+	}
+	listing := make([]ruleListing, 0, len(rs))
+	for _, r := range rs {
+		listing = append(listing, ruleListing{ID: r.ID(), Metadata: engine.Describe(r)})
+	}
+	if format == "json" {
+		return json.NewEncoder(out).Encode(listing)
+	}
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tCATEGORY\tSEVERITY\tDEFAULT\tFIXABLE\tTITLE")
+	for _, r := range listing {
+		category := string(r.Category)
+		if category == "" {
+			category = "-"
+		}
+		enabled := "on"
+		if r.OptIn {
+			enabled = "opt-in"
+		}
+		fixable := "no"
+		if r.Fixable {
+			fixable = "yes"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", r.ID, category, r.Severity, enabled, fixable, r.Title)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,142 @@
+// file: cmd/docker-lint/rules_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestIntegrationRunRulesText verifies the rules listing table.
+func TestIntegrationRunRulesText(t *testing.T) {
+	var out bytes.Buffer
+	if err := run([]string{"rules", "-c", filepath.Join(t.TempDir(), "none.yaml")}, &out, io.Discard, false); err == nil {
+		t.Fatal("expected missing config error")
+	}
+	if err := run([]string{"rules"}, &out, io.Discard, false); err != nil {
+		t.Fatalf("run rules: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if !strings.HasPrefix(lines[0], "ID ") || !strings.Contains(out.String(), "DL3015  package-management  info      on       yes      Use --no-install-recommends with apt-get") {
+		t.Fatalf("unexpected listing:\n%s", out.String())
+	}
+	if strings.Contains(out.String(), "DL3057") {
+		t.Fatalf("opt-in rule listed without --all:\n%s", out.String())
+	}
+	out.Reset()
+	if err := run([]string{"rules", "--all", "--only", "DL3007"}, &out, io.Discard, false); err != nil {
+		t.Fatalf("run rules: %v", err)
+	}
+	if !strings.Contains(out.String(), "DL3057  maintainability     info      opt-in") {
+		t.Fatalf("expected opt-in DL3057 with --all:\n%s", out.String())
+	}
+}
+
+// TestIntegrationRunRulesJSON verifies that the JSON listing includes configured rules
+// and honors category selection.
+func TestIntegrationRunRulesJSON(t *testing.T) {
+	cfg := filepath.Join(t.TempDir(), "cfg.yaml")
+	src := "custom-rules:\n  - id: ORG001\n    message: no curl\n    category: security\n    instruction: run\n    match:\n      command: curl\n"
+	if err := os.WriteFile(cfg, []byte(src), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	var out bytes.Buffer
+	args := []string{"rules", "-c", cfg, "--format", "json", "--enable-category", "security,multi-stage", "--disable-category", "multi-stage", "--disable", "DL*"}
+	if err := run(args, &out, io.Discard, false); err != nil {
+		t.Fatalf("run rules: %v", err)
+	}
+	var got []ruleListing
+	if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+		t.Fatalf("decode: %v\n%s", err, out.String())
+	}
+	if len(got) != 1 || got[0].ID != "ORG001" || got[0].Title != "no curl" || got[0].Severity != "warning" {
+		t.Fatalf("unexpected listing %+v", got)
+	}
+}
+
+// TestRunRulesErrors covers argument errors for the rules subcommand.
+func TestRunRulesErrors(t *testing.T) {
+	cases := map[string][]string{
+		"missing config file":  {"rules", "-c"},
+		"missing format":       {"rules", "--format"},
+		`unknown format "xml"`: {"rules", "--format", "xml"},
+		"missing value":        {"rules", "--enable-category"},
+		`no rule matches "X*"`: {"rules", "--enable", "X*"},
+		"invalid rule pattern": {"rules", "--only", "DL["},
+		`unknown category "x"`: {"rules", "--disable-category", "security,x"},
+		"unexpected argument":  {"rules", "Dockerfile"},
+	}
+	for want, args := range cases {
+		err := run(args, io.Discard, io.Discard, false)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("%v: expected error containing %q, got %v", args, want, err)
+		}
+	}
+	var out bytes.Buffer
+	if err := run([]string{"rules", "--help"}, &out, io.Discard, false); err != nil || out.String() != rulesUsageText+"\n" {
+		t.Fatalf("unexpected help %q, %v", out.String(), err)
+	}
+}
+
+// TestIntegrationRunCategorySelection verifies that category flags select the rules run.
+func TestIntegrationRunCategorySelection(t *testing.T) {
+	df := testDataPath("Dockerfile.bad")
+	var out bytes.Buffer
+	if err := run([]string{"--no-cache", "--enable-category", "package-management", df}, &out, io.Discard, false); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if strings.TrimSpace(out.String()) != "null" {
+		t.Fatalf("expected no findings, got %s", out.String())
+	}
+	out.Reset()
+	if err := run([]string{"--no-cache", "--disable-category", "package-management", df}, &out, io.Discard, false); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if !strings.Contains(out.String(), "DL3007") {
+		t.Fatalf("expected DL3007, got %s", out.String())
+	}
+	if err := run([]string{"--enable-category", "speed", df}, io.Discard, io.Discard, false); err == nil || !strings.Contains(err.Error(), `unknown category "speed"`) {
+		t.Fatalf("expected category error, got %v", err)
+	}
+}
+
+// TestIntegrationRunRuleSelection verifies the --enable, --only and --disable flags.
+func TestIntegrationRunRuleSelection(t *testing.T) {
+	df := testDataPath("Dockerfile.bad")
+	cases := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"--only", "DL3007"}, "DL3007"},
+		{[]string{"--disable", "DL300*,DL3043"}, ""},
+		{[]string{"--only", "DL3007", "--enable", "DL3057"}, "DL3007"},
+		{[]string{"--enable", "DL3057", "--disable", "DL300*,DL3043"}, "DL3057"},
+	}
+	for _, c := range cases {
+		var out bytes.Buffer
+		if err := run(append([]string{"--no-cache"}, append(c.args, df)...), &out, io.Discard, false); err != nil {
+			t.Fatalf("%v: run: %v", c.args, err)
+		}
+		var findings []struct {
+			Rule string `json:"rule"`
+		}
+		if err := json.Unmarshal(out.Bytes(), &findings); err != nil {
+			t.Fatalf("%v: decode: %v", c.args, err)
+		}
+		var ids []string
+		for _, f := range findings {
+			ids = append(ids, f.Rule)
+		}
+		if strings.Join(ids, ",") != c.want {
+			t.Fatalf("%v: got findings %v; want %s", c.args, ids, c.want)
+		}
+	}
+	if err := run([]string{"--only", "DL9999", df}, io.Discard, io.Discard, false); err == nil || !strings.Contains(err.Error(), `no rule matches "DL9999"`) {
+		t.Fatalf("expected unmatched rule error, got %v", err)
+	}
+}
@@ -0,0 +1,164 @@
+// file: cmd/docker-lint/sarif.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"path/filepath"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/version"
+)
+
+// SARIF 2.1.0 identifiers.
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifInfoURI = "https://github.com/asymmetric-effort/docker-lint"
+)
+
+// sarifLog is the top-level SARIF document.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+// sarifRun holds the results of one docker-lint invocation.
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+// sarifTool describes the analysis tool that produced a run.
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+// sarifDriver identifies docker-lint and the rules it ran.
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+// sarifRule describes a rule from its metadata.
+type sarifRule struct {
+	ID                   string          `json:"id"`
+	ShortDescription     sarifText       `json:"shortDescription"`
+	FullDescription      *sarifText      `json:"fullDescription,omitempty"`
+	HelpURI              string          `json:"helpUri,omitempty"`
+	DefaultConfiguration sarifRuleConfig `json:"defaultConfiguration"`
+	Properties           sarifRuleProps  `json:"properties"`
+}
+
+// sarifRuleConfig holds the default level of a rule.
+type sarifRuleConfig struct {
+	Level string `json:"level"`
+}
+
+// sarifRuleProps carries docker-lint specific rule metadata.
+type sarifRuleProps struct {
+	Category engine.Category `json:"category,omitempty"`
+	Tags     []string        `json:"tags,omitempty"`
+	Fixable  bool            `json:"fixable"`
+}
+
+// sarifText is a plain text message.
+type sarifText struct {
+	Text string `json:"text"`
+}
+
+// sarifResult reports a single finding.
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex *int            `json:"ruleIndex,omitempty"`
+	Level     string          `json:"level"`
+	Message   sarifText       `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+// sarifLocation is where a finding occurred.
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+// sarifPhysicalLocation locates a finding within a file.
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifact `json:"artifactLocation"`
+	Region           *sarifRegion  `json:"region,omitempty"`
+}
+
+// sarifArtifact names the linted file.
+type sarifArtifact struct {
+	URI string `json:"uri"`
+}
+
+// sarifRegion is the line of a finding within its file.
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+// sarifLevel maps a docker-lint severity to a SARIF result level.
+func sarifLevel(severity string) string {
+	switch severity {
+	case engine.SeverityError:
+		return "error"
+	case engine.SeverityInfo, engine.SeverityStyle:
+		return "note"
+	default:
+		return "warning"
+	}
+}
+
+// writeSARIF writes the findings for files as a SARIF 2.1.0 log.
+//
+// The rules registered for the run are described from their metadata, and
+// results[i] holds the findings for files[i]. A finding without a severity is
+// reported at its rule's default severity.
+func writeSARIF(out io.Writer, rs []engine.Rule, files []string, results [][]engine.Finding) error {
+	driver := sarifDriver{Name: "docker-lint", Version: version.Current, InformationURI: sarifInfoURI, Rules: []sarifRule{}}
+	index := map[string]int{}
+	meta := map[string]engine.Metadata{}
+	for _, r := range rs {
+		m := engine.Describe(r)
+		index[r.ID()] = len(driver.Rules)
+		meta[r.ID()] = m
+		rule := sarifRule{
+			ID:                   r.ID(),
+			ShortDescription:     sarifText{Text: m.Title},
+			HelpURI:              m.DocsURL,
+			DefaultConfiguration: sarifRuleConfig{Level: sarifLevel(m.Severity)},
+			Properties:           sarifRuleProps{Category: m.Category, Tags: m.Tags, Fixable: m.Fixable},
+		}
+		if m.Description != "" {
+			rule.FullDescription = &sarifText{Text: m.Description}
+		}
+		driver.Rules = append(driver.Rules, rule)
+	}
+	run := sarifRun{Tool: sarifTool{Driver: driver}, Results: []sarifResult{}}
+	for i, fnds := range results {
+		for _, f := range fnds {
+			res := sarifResult{RuleID: f.RuleID, Message: sarifText{Text: f.Message}}
+			severity := f.Severity
+			if idx, ok := index[f.RuleID]; ok {
+				res.RuleIndex = &idx
+				if severity == "" {
+					severity = meta[f.RuleID].Severity
+				}
+			}
+			res.Level = sarifLevel(severity)
+			loc := sarifPhysicalLocation{ArtifactLocation: sarifArtifact{URI: filepath.ToSlash(files[i])}}
+			if f.Line > 0 {
+				loc.Region = &sarifRegion{StartLine: f.Line}
+			}
+			res.Locations = []sarifLocation{{PhysicalLocation: loc}}
+			run.Results = append(run.Results, res)
+		}
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(sarifLog{Schema: sarifSchema, Version: sarifVersion, Runs: []sarifRun{run}})
+}
@@ -0,0 +1,68 @@
+// file: cmd/docker-lint/sarif_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+)
+
+// TestIntegrationRunSARIF verifies the SARIF log describes rules and locates results.
+func TestIntegrationRunSARIF(t *testing.T) {
+	df := testDataPath("Dockerfile.bad")
+	var out bytes.Buffer
+	if err := run([]string{"--no-cache", "--format", "sarif", "--only", "DL3007,DL3043", df}, &out, io.Discard, false); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	var log sarifLog
+	if err := json.Unmarshal(out.Bytes(), &log); err != nil {
+		t.Fatalf("decode: %v\n%s", err, out.String())
+	}
+	if log.Version != "2.1.0" || len(log.Runs) != 1 {
+		t.Fatalf("unexpected log %+v", log)
+	}
+	r := log.Runs[0]
+	if r.Tool.Driver.Name != "docker-lint" || len(r.Tool.Driver.Rules) == 0 {
+		t.Fatalf("unexpected driver %+v", r.Tool.Driver)
+	}
+	if len(r.Results) != 2 {
+		t.Fatalf("expected 2 results, got %+v", r.Results)
+	}
+	res := r.Results[0]
+	rule := r.Tool.Driver.Rules[*res.RuleIndex]
+	if res.RuleID != "DL3007" || rule.ID != "DL3007" || res.Level != "warning" || !strings.HasSuffix(rule.HelpURI, "DL3007.md") {
+		t.Fatalf("unexpected result %+v for rule %+v", res, rule)
+	}
+	loc := res.Locations[0].PhysicalLocation
+	if !strings.HasSuffix(loc.ArtifactLocation.URI, "testdata/Dockerfile.bad") || loc.Region.StartLine != 1 {
+		t.Fatalf("unexpected location %+v", loc)
+	}
+	if err := run([]string{"--format", "xml", df}, io.Discard, io.Discard, false); err == nil || !strings.Contains(err.Error(), `unknown format "xml"`) {
+		t.Fatalf("expected format error, got %v", err)
+	}
+}
+
+// TestSARIFLevels verifies severity mapping, including findings for unregistered rules.
+func TestSARIFLevels(t *testing.T) {
+	var out bytes.Buffer
+	fnds := [][]engine.Finding{{
+		{RuleID: "ORG1", Message: "m", Severity: "style"},
+		{RuleID: "plugin/acme", Message: "failed", Severity: "error"},
+	}}
+	if err := writeSARIF(&out, nil, []string{"Dockerfile"}, fnds); err != nil {
+		t.Fatalf("writeSARIF: %v", err)
+	}
+	var log sarifLog
+	if err := json.Unmarshal(out.Bytes(), &log); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	res := log.Runs[0].Results
+	if res[0].Level != "note" || res[1].Level != "error" || res[0].RuleIndex != nil || res[0].Locations[0].PhysicalLocation.Region != nil {
+		t.Fatalf("unexpected results %+v", res)
+	}
+}
@@ -0,0 +1,115 @@
+// file: cmd/docker-lint/watch.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/asymmetric-effort/docker-lint/internal/dockerignore"
+)
+
+// watchInterval is how often watch mode polls the watched paths for changes.
+var watchInterval = 500 * time.Millisecond
+
+// clearScreen moves the cursor home and clears the terminal.
+const clearScreen = "\033[H\033[2J"
+
+// fileState is the modification time and size of a watched file.
+type fileState struct {
+	mod  time.Time
+	size int64
+}
+
+// watch re-lints patterns whenever a matching file is added, removed or
+// modified, until ctx is cancelled.
+//
+// Patterns are re-expanded on every poll so that new Dockerfiles are picked
+// up. Each pass clears the screen and prints a findings summary per file to
+// errOut; lint errors are reported without stopping the watch.
+func (l *linter) watch(ctx context.Context, patterns, exclude []string, jobs int, errOut io.Writer, color bool) error {
+	var last map[string]fileState
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+	for {
+		files, err := expandPaths(patterns, exclude)
+		if err != nil {
+			return err
+		}
+		if snap := snapshot(files, l.contextDir); !sameSnapshot(last, snap) {
+			last = snap
+			l.report(ctx, files, jobs, errOut, color)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// report clears the screen and prints the findings of each file.
+func (l *linter) report(ctx context.Context, files []string, jobs int, errOut io.Writer, color bool) {
+	fmt.Fprint(errOut, clearScreen)
+	fmt.Fprintf(errOut, "docker-lint: watching %d file(s), last run %s\n", len(files), time.Now().Format(time.TimeOnly))
+	results, err := l.lintFiles(ctx, files, jobs)
+	if err != nil {
+		printError(errOut, color, err)
+		return
+	}
+	for i, fnds := range results {
+		fmt.Fprintf(errOut, "\n%s\n", files[i])
+		printFindings(errOut, fnds, color, l.docs)
+	}
+}
+
+// snapshot records the state of each file and of the .dockerignore files
+// that may apply to it within contextDir, which defaults to the file's
+// directory; missing files are omitted.
+//
+// An explicit contextDir is walked as well, so that adding, removing or
+// changing a build context file re-runs the context rules.
+func snapshot(files []string, contextDir string) map[string]fileState {
+	snap := make(map[string]fileState, len(files))
+	for _, f := range files {
+		dir := contextDir
+		if dir == "" {
+			dir = filepath.Dir(f)
+		}
+		for _, p := range []string{f, f + dockerignore.Name, filepath.Join(dir, dockerignore.Name)} {
+			if fi, err := os.Stat(p); err == nil {
+				snap[p] = fileState{mod: fi.ModTime(), size: fi.Size()}
+			}
+		}
+	}
+	if contextDir != "" {
+		_ = filepath.WalkDir(contextDir, func(p string, e fs.DirEntry, err error) error {
+			if err != nil {
+				return nil
+			}
+			if fi, err := e.Info(); err == nil {
+				snap[p] = fileState{mod: fi.ModTime(), size: fi.Size()}
+			}
+			return nil
+		})
+	}
+	return snap
+}
+
+// sameSnapshot reports whether two snapshots describe identical files.
+func sameSnapshot(a, b map[string]fileState) bool {
+	if a == nil || len(a) != len(b) {
+		return false
+	}
+	for f, st := range b {
+		if prev, ok := a[f]; !ok || !prev.mod.Equal(st.mod) || prev.size != st.size {
+			return false
+		}
+	}
+	return true
+}
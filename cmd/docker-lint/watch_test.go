@@ -0,0 +1,119 @@
+// file: cmd/docker-lint/watch_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+)
+
+// syncBuffer is a bytes.Buffer safe for concurrent writes and reads.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// waitFor polls until the buffer contains s or the deadline passes.
+func waitFor(t *testing.T, b *syncBuffer, s string) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !strings.Contains(b.String(), s) {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %q in %q", s, b.String())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// TestIntegrationWatch verifies that watch mode re-lints a file when it changes.
+func TestIntegrationWatch(t *testing.T) {
+	prev := watchInterval
+	watchInterval = 10 * time.Millisecond
+	t.Cleanup(func() { watchInterval = prev })
+
+	df := filepath.Join(t.TempDir(), "Dockerfile")
+	if err := os.WriteFile(df, []byte("FROM alpine:latest\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	reg := engine.NewRegistry()
+	if err := registerRules(context.Background(), reg, nil, engine.Selection{}); err != nil {
+		t.Fatal(err)
+	}
+	l := &linter{reg: reg}
+	ctx, cancel := context.WithCancel(context.Background())
+	var out syncBuffer
+	done := make(chan error, 1)
+	go func() { done <- l.watch(ctx, []string{df}, nil, 1, &out, false) }()
+
+	waitFor(t, &out, "rule: DL3007")
+	if !strings.Contains(out.String(), clearScreen) {
+		t.Fatalf("expected clear-screen sequence")
+	}
+	if err := os.WriteFile(df, []byte("FROM alpine:3.19\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	future := time.Now().Add(time.Minute)
+	if err := os.Chtimes(df, future, future); err != nil {
+		t.Fatalf("chtimes: %v", err)
+	}
+	waitFor(t, &out, "No issues found")
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("watch returned %v", err)
+	}
+}
+
+// TestSameSnapshot verifies change detection between snapshots.
+func TestSameSnapshot(t *testing.T) {
+	now := time.Now()
+	a := map[string]fileState{"f": {mod: now, size: 1}}
+	if sameSnapshot(nil, a) {
+		t.Fatalf("first snapshot must differ")
+	}
+	if !sameSnapshot(a, map[string]fileState{"f": {mod: now, size: 1}}) {
+		t.Fatalf("expected identical snapshots")
+	}
+	if sameSnapshot(a, map[string]fileState{"f": {mod: now, size: 2}}) || sameSnapshot(a, map[string]fileState{"g": {mod: now, size: 1}}) {
+		t.Fatalf("expected changed snapshots")
+	}
+}
+
+// TestSnapshotContext verifies that context files are watched only for an
+// explicit build context.
+func TestSnapshotContext(t *testing.T) {
+	dir := t.TempDir()
+	df := filepath.Join(dir, "Dockerfile")
+	if err := os.WriteFile(df, []byte("FROM alpine:3.20\nCOPY app.sh /\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	before, implicit := snapshot([]string{df}, dir), snapshot([]string{df}, "")
+	if err := os.WriteFile(filepath.Join(dir, "app.sh"), []byte("echo hi\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if sameSnapshot(before, snapshot([]string{df}, dir)) {
+		t.Fatalf("expected a new context file to change the snapshot")
+	}
+	if !sameSnapshot(implicit, snapshot([]string{df}, "")) {
+		t.Fatalf("expected context files to be ignored without an explicit context")
+	}
+}
@@ -0,0 +1,120 @@
+// file: internal/cache/cache.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+
+// Package cache stores lint findings on disk so unchanged files can be skipped.
+//
+// Entries are keyed by a hash of every input that determines a file's
+// findings: its content, the effective configuration, the registered rule set
+// and the linter version. A changed input yields a new key, so stale entries
+// are never replayed; they are simply left behind until the cache is cleaned.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+)
+
+// dirName is the cache subdirectory used by docker-lint.
+const dirName = "docker-lint"
+
+// Cache is an on-disk store of findings keyed by content hash.
+//
+// Cache is safe for concurrent use by multiple goroutines and processes:
+// entries are written to a temporary file and renamed into place.
+type Cache struct {
+	dir string
+}
+
+// Dir returns the default cache directory.
+//
+// Dir uses $XDG_CACHE_HOME/docker-lint when XDG_CACHE_HOME is set and the
+// platform user cache directory otherwise.
+func Dir() (string, error) {
+	if xdg := os.Getenv("XDG_CACHE_HOME"); xdg != "" {
+		return filepath.Join(xdg, dirName), nil
+	}
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(base, dirName), nil
+}
+
+// New returns a cache rooted at dir. The directory is created on first write.
+func New(dir string) *Cache { return &Cache{dir: dir} }
+
+// Key returns the hex SHA-256 digest of parts.
+//
+// Each part is length-prefixed so that different splits of the same bytes
+// produce different keys.
+func Key(parts ...[]byte) string {
+	h := sha256.New()
+	var n [8]byte
+	for _, p := range parts {
+		binary.BigEndian.PutUint64(n[:], uint64(len(p)))
+		h.Write(n[:])
+		h.Write(p)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Get returns the findings stored under key.
+//
+// Missing, unreadable or corrupt entries are reported as misses.
+func (c *Cache) Get(key string) ([]engine.Finding, bool) {
+	b, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	var findings []engine.Finding
+	if err := json.Unmarshal(b, &findings); err != nil {
+		return nil, false
+	}
+	return findings, true
+}
+
+// Put stores findings under key.
+func (c *Cache) Put(key string, findings []engine.Finding) error {
+	b, err := json.Marshal(findings)
+	if err != nil {
+		return err
+	}
+	p := c.path(key)
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	_, werr := tmp.Write(b)
+	cerr := tmp.Close()
+	if err := errors.Join(werr, cerr); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), p); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
+
+// Clean removes every cache entry.
+func (c *Cache) Clean() error { return os.RemoveAll(c.dir) }
+
+// path returns the file holding the entry for key, sharded by its first byte.
+func (c *Cache) path(key string) string {
+	shard := key
+	if len(shard) > 2 {
+		shard = shard[:2]
+	}
+	return filepath.Join(c.dir, shard, key+".json")
+}
@@ -0,0 +1,69 @@
+// file: internal/cache/cache_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+)
+
+// TestCacheRoundTrip verifies that stored findings are returned and misses are reported.
+func TestCacheRoundTrip(t *testing.T) {
+	c := New(filepath.Join(t.TempDir(), "cache"))
+	key := Key([]byte("salt"), []byte("FROM alpine\n"))
+	if _, ok := c.Get(key); ok {
+		t.Fatalf("expected miss on empty cache")
+	}
+	want := []engine.Finding{{RuleID: "DL3007", Message: "m", Line: 1}}
+	if err := c.Put(key, want); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	got, ok := c.Get(key)
+	if !ok || !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, %v; want %v", got, ok, want)
+	}
+	if err := c.Clean(); err != nil {
+		t.Fatalf("clean: %v", err)
+	}
+	if _, ok := c.Get(key); ok {
+		t.Fatalf("expected miss after clean")
+	}
+}
+
+// TestCacheCorruptEntry verifies that unreadable entries are treated as misses.
+func TestCacheCorruptEntry(t *testing.T) {
+	c := New(t.TempDir())
+	key := Key([]byte("x"))
+	if err := os.MkdirAll(filepath.Dir(c.path(key)), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(c.path(key), []byte("{not json"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if _, ok := c.Get(key); ok {
+		t.Fatalf("expected miss for corrupt entry")
+	}
+}
+
+// TestKeyLengthPrefixed verifies that part boundaries change the key.
+func TestKeyLengthPrefixed(t *testing.T) {
+	if Key([]byte("ab"), []byte("c")) == Key([]byte("a"), []byte("bc")) {
+		t.Fatalf("expected distinct keys for different splits")
+	}
+	if Key([]byte("a")) != Key([]byte("a")) {
+		t.Fatalf("expected stable keys")
+	}
+}
+
+// TestDir verifies that XDG_CACHE_HOME selects the cache directory.
+func TestDir(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", "/tmp/xdg")
+	dir, err := Dir()
+	if err != nil || dir != filepath.Join("/tmp/xdg", "docker-lint") {
+		t.Fatalf("got %q, %v", dir, err)
+	}
+}
@@ -3,8 +3,13 @@
 package config
 
 import (
-	"gopkg.in/yaml.v3"
 	"os"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
 )
 
 // Config represents docker-lint configuration settings.
@@ -14,8 +19,15 @@ import (
 // options are currently consumed by docker-lint.
 type Config struct {
 	// Ignored lists rule IDs that should be skipped globally during linting.
+	// It is kept for hadolint compatibility and behaves like Disable.
 	Ignored []string `yaml:"ignored"`
 
+	// Enable lists rule ID patterns, such as DL30*, of opt-in rules to run.
+	Enable []string `yaml:"enable"`
+
+	// Disable lists rule ID patterns of rules that never run.
+	Disable []string `yaml:"disable"`
+
 	// Override remaps rule IDs to a severity level, keyed by that level.
 	Override map[string][]string `yaml:"override"`
 
@@ -25,11 +37,135 @@ type Config struct {
 	// TrustedRegistries specifies registries considered secure for FROM instructions.
 	TrustedRegistries []string `yaml:"trustedRegistries"`
 
+	// AllowedPorts lists the ports and ranges, such as 8080, 8000-8099 or
+	// 53/udp, that the final image may expose; empty allows every port.
+	AllowedPorts []string `yaml:"allowed-ports"`
+
+	// DeniedPorts lists the ports and ranges that the final image must not expose.
+	DeniedPorts []string `yaml:"denied-ports"`
+
 	// StrictLabels toggles enforcement of a configured label schema.
 	StrictLabels bool `yaml:"strict-labels"`
 
 	// LabelSchema maps required label keys to a description or type.
 	LabelSchema map[string]string `yaml:"label-schema"`
+
+	// CustomRules declares organization-specific rules evaluated alongside the built-in ones.
+	CustomRules []CustomRule `yaml:"custom-rules"`
+
+	// Plugins lists external executables that implement additional rules.
+	Plugins []Plugin `yaml:"plugins"`
+
+	// Policies declares rules written in the policy expression language.
+	Policies []Policy `yaml:"policies"`
+}
+
+// Policy declares a rule as a boolean expression over the document model.
+//
+// The expression in Deny reports a finding when it is true; the expression in
+// Require reports one when it is false. Exactly one of them must be set.
+type Policy struct {
+	// ID identifies the rule in findings and ignore lists.
+	ID string `yaml:"id"`
+
+	// Severity is one of error, warning, info or style; it defaults to warning.
+	Severity string `yaml:"severity"`
+
+	// Message is reported with each finding and titles the rule.
+	Message string `yaml:"message"`
+
+	// Category is one of the rule categories, such as security; it may be empty.
+	Category string `yaml:"category"`
+
+	// DocsURL links to documentation for the rule.
+	DocsURL string `yaml:"docs-url"`
+
+	// For is the scope the policy is evaluated in: document, stage (the
+	// default) or instruction.
+	For string `yaml:"for"`
+
+	// Deny is an expression that holds for violations.
+	Deny string `yaml:"deny"`
+
+	// Require is an expression that must hold.
+	Require string `yaml:"require"`
+}
+
+// Plugin configures an external rule executable.
+type Plugin struct {
+	// Name identifies the plugin in failure reports.
+	Name string `yaml:"name"`
+
+	// Command is the executable to run, resolved through PATH when it has no slash.
+	Command string `yaml:"command"`
+
+	// Args are passed to the executable on every invocation.
+	Args []string `yaml:"args"`
+
+	// Timeout bounds each invocation, such as "5s"; zero uses the default.
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// CustomRule declares a rule in the custom-rules section.
+//
+// The rule selects instructions by keyword and applies the predicates in
+// Match, all of which must hold for an instruction to match. By default every
+// matching instruction is reported; with Require the rule instead reports the
+// build target when no instruction in it matches.
+type CustomRule struct {
+	// ID identifies the rule in findings and ignore lists.
+	ID string `yaml:"id"`
+
+	// Severity is one of error, warning, info or style; it defaults to warning.
+	Severity string `yaml:"severity"`
+
+	// Message is reported with each finding and titles the rule.
+	Message string `yaml:"message"`
+
+	// Category is one of the rule categories, such as security; it may be empty.
+	Category string `yaml:"category"`
+
+	// DocsURL links to documentation for the rule.
+	DocsURL string `yaml:"docs-url"`
+
+	// Instruction restricts the rule to instructions with this keyword, such
+	// as RUN, FROM or LABEL; empty selects every instruction.
+	Instruction string `yaml:"instruction"`
+
+	// Require reports the absence of a matching instruction instead of its presence.
+	Require bool `yaml:"require"`
+
+	// Match holds the predicates an instruction must satisfy.
+	Match CustomMatch `yaml:"match"`
+}
+
+// CustomMatch holds the predicates of a custom rule. Empty fields are not checked.
+type CustomMatch struct {
+	// Regex must match the instruction arguments, including heredoc bodies.
+	Regex string `yaml:"regex"`
+
+	// NotRegex must not match the instruction arguments.
+	NotRegex string `yaml:"not-regex"`
+
+	// Command must be run by the RUN instruction.
+	Command string `yaml:"command"`
+
+	// NotCommand must not be run by the RUN instruction.
+	NotCommand string `yaml:"not-command"`
+
+	// Flag must be passed to the instruction, or to Command when it is set,
+	// or else to any command of the RUN instruction.
+	Flag string `yaml:"flag"`
+
+	// NotFlag must not be passed, with the same scope as Flag.
+	NotFlag string `yaml:"not-flag"`
+
+	// LabelKey is a regular expression that must match a LABEL key.
+	LabelKey string `yaml:"label-key"`
+
+	// LabelValue is a regular expression that must match the value of a
+	// LABEL pair whose key matches LabelKey.
+	LabelValue string `yaml:"label-value"`
 }
 
 // Load reads the configuration from the given YAML file path.
@@ -45,13 +181,14 @@ func Load(path string) (*Config, error) {
 	return &cfg, nil
 }
 
-// IsIgnored reports whether the given rule ID is globally ignored.
+// IsIgnored reports whether the given rule ID matches a pattern of the
+// ignored or disable lists.
 func (c *Config) IsIgnored(rule string) bool {
 	if c == nil {
 		return false
 	}
-	for _, r := range c.Ignored {
-		if r == rule {
+	for _, p := range append(slices.Clip(c.Ignored), c.Disable...) {
+		if ok, _ := path.Match(strings.ToUpper(p), strings.ToUpper(rule)); ok {
 			return true
 		}
 	}
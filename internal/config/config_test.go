@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 )
 
 // TestLoad verifies that Load parses hadolint-style configuration files.
@@ -21,6 +22,10 @@ func TestLoad(t *testing.T) {
 		"failure-threshold: warning\n" +
 		"trustedRegistries:\n" +
 		"  - ghcr.io\n" +
+		"allowed-ports:\n" +
+		"  - 8000-8099\n" +
+		"denied-ports:\n" +
+		"  - 22\n" +
 		"strict-labels: true\n" +
 		"label-schema:\n" +
 		"  author: text\n")
@@ -49,6 +54,9 @@ func TestLoad(t *testing.T) {
 	if len(cfg.TrustedRegistries) != 1 || cfg.TrustedRegistries[0] != "ghcr.io" {
 		t.Fatalf("unexpected registries: %v", cfg.TrustedRegistries)
 	}
+	if len(cfg.AllowedPorts) != 1 || cfg.AllowedPorts[0] != "8000-8099" || len(cfg.DeniedPorts) != 1 || cfg.DeniedPorts[0] != "22" {
+		t.Fatalf("unexpected ports: %v %v", cfg.AllowedPorts, cfg.DeniedPorts)
+	}
 }
 
 // TestIsIgnored verifies that IsIgnored returns true for configured rules.
@@ -62,6 +70,24 @@ func TestIsIgnored(t *testing.T) {
 	}
 }
 
+// TestIsIgnoredDisablePatterns verifies that disable patterns match rule IDs case-insensitively.
+func TestIsIgnoredDisablePatterns(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cfg.yaml")
+	if err := os.WriteFile(path, []byte("enable:\n  - DL3057\ndisable:\n  - dl30*\n"), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if len(cfg.Enable) != 1 || cfg.Enable[0] != "DL3057" {
+		t.Fatalf("unexpected enable: %v", cfg.Enable)
+	}
+	if !cfg.IsIgnored("DL3007") || cfg.IsIgnored("DL4006") {
+		t.Fatalf("unexpected disable matching")
+	}
+}
+
 // TestLoadMissingFile ensures Load returns an error when the file is absent.
 func TestLoadMissingFile(t *testing.T) {
 	if _, err := Load("non-existent.yaml"); err == nil {
@@ -88,3 +114,33 @@ func TestIsIgnoredNilConfig(t *testing.T) {
 		t.Fatalf("expected nil config to not ignore")
 	}
 }
+
+// TestLoadExtensions verifies parsing of custom-rules and plugins.
+func TestLoadExtensions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cfg.yaml")
+	src := []byte("" +
+		"custom-rules:\n" +
+		"  - id: ACME001\n" +
+		"    message: no curl\n" +
+		"    instruction: RUN\n" +
+		"    match:\n" +
+		"      command: curl\n" +
+		"plugins:\n" +
+		"  - name: acme\n" +
+		"    command: ./acme-rules\n" +
+		"    args: [--strict]\n" +
+		"    timeout: 5s\n")
+	if err := os.WriteFile(path, src, 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if len(cfg.CustomRules) != 1 || cfg.CustomRules[0].Match.Command != "curl" {
+		t.Fatalf("unexpected custom rules: %+v", cfg.CustomRules)
+	}
+	if len(cfg.Plugins) != 1 || cfg.Plugins[0].Timeout != 5*time.Second || cfg.Plugins[0].Args[0] != "--strict" {
+		t.Fatalf("unexpected plugins: %+v", cfg.Plugins)
+	}
+}
@@ -0,0 +1,103 @@
+// file: internal/discover/discover.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+
+// Package discover finds Dockerfiles in directory trees.
+//
+// Walk recognizes the file names used by Docker, BuildKit and Podman, honors
+// .gitignore and .docker-lint-ignore files found along the way, applies
+// caller-supplied exclude globs and skips vendored dependency trees.
+package discover
+
+import (
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	doublestar "github.com/bmatcuk/doublestar/v4"
+
+	"github.com/asymmetric-effort/docker-lint/internal/dockerignore"
+)
+
+// IgnoreFile is the docker-lint specific ignore file, using .gitignore syntax.
+const IgnoreFile = ".docker-lint-ignore"
+
+// skippedDirs lists directories that are never descended into.
+var skippedDirs = map[string]struct{}{".git": {}, "vendor": {}, "node_modules": {}}
+
+// IsDockerfile reports whether a file name denotes a Dockerfile or Containerfile.
+//
+// Recognized names are Dockerfile, Dockerfile.*, *.Dockerfile, Containerfile,
+// Containerfile.* and *.containerfile, matched case-insensitively. Ignore
+// files such as Dockerfile.dockerignore are not Dockerfiles.
+func IsDockerfile(name string) bool {
+	lower := strings.ToLower(name)
+	if strings.HasSuffix(lower, dockerignore.Name) {
+		return false
+	}
+	for _, base := range []string{"dockerfile", "containerfile"} {
+		if lower == base || strings.HasPrefix(lower, base+".") || strings.HasSuffix(lower, "."+base) {
+			return true
+		}
+	}
+	return false
+}
+
+// Walk returns the Dockerfiles below root in lexical order.
+//
+// Files and directories matched by a .gitignore or .docker-lint-ignore file,
+// or by one of the exclude globs, are skipped, as are .git, vendor and
+// node_modules directories. Exclude globs support `**` and are matched
+// against paths relative to root and against base names.
+func Walk(root string, exclude []string) ([]string, error) {
+	var files []string
+	ignores := map[string]ignoreList{}
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		parent := ignores[path.Dir(rel)]
+		if d.IsDir() {
+			if rel != "." {
+				if _, skip := skippedDirs[d.Name()]; skip || parent.ignored(rel, true) || Excluded(rel, exclude) {
+					return filepath.SkipDir
+				}
+			}
+			list, err := loadIgnores(p, rel, parent)
+			if err != nil {
+				return err
+			}
+			ignores[rel] = list
+			return nil
+		}
+		if !IsDockerfile(d.Name()) || parent.ignored(rel, false) || Excluded(rel, exclude) {
+			return nil
+		}
+		files = append(files, p)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// Excluded reports whether a slash-separated path, or its base name, matches any of the globs.
+func Excluded(p string, globs []string) bool {
+	p = filepath.ToSlash(p)
+	for _, g := range globs {
+		g = strings.TrimSuffix(filepath.ToSlash(g), "/")
+		if ok, _ := doublestar.Match(g, p); ok {
+			return true
+		}
+		if ok, _ := doublestar.Match(g, path.Base(p)); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+// file: internal/discover/discover_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package discover
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// writeTree creates files with the given contents below root.
+func writeTree(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		p := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+}
+
+// TestIsDockerfile verifies recognized Dockerfile names.
+func TestIsDockerfile(t *testing.T) {
+	cases := map[string]bool{
+		"Dockerfile":                     true,
+		"Dockerfile.dev":                 true,
+		"api.Dockerfile":                 true,
+		"Containerfile":                  true,
+		"web.containerfile":              true,
+		"dockerfile":                     true,
+		"Dockerfiles":                    false,
+		"README.md":                      false,
+		"Dockerfile-template":            false,
+		"Dockerfile.dockerignore":        false,
+		"api.Containerfile.dockerignore": false,
+	}
+	for name, want := range cases {
+		if got := IsDockerfile(name); got != want {
+			t.Fatalf("IsDockerfile(%q) = %v; want %v", name, got, want)
+		}
+	}
+}
+
+// TestIntegrationWalk verifies discovery, ignore files, excludes and skipped directories.
+func TestIntegrationWalk(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"Dockerfile":                         "",
+		"README.md":                          "",
+		".gitignore":                         "build/\n*.tmp.Dockerfile\n",
+		IgnoreFile:                           "legacy/*/Dockerfile\n!legacy/keep/Dockerfile\n",
+		"svc/api.Dockerfile":                 "",
+		"svc/Containerfile":                  "",
+		"svc/.gitignore":                     "/local.Dockerfile\n",
+		"svc/local.Dockerfile":               "",
+		"svc/nested/local.Dockerfile":        "",
+		"svc/x.tmp.Dockerfile":               "",
+		"build/Dockerfile":                   "",
+		"legacy/old/Dockerfile":              "",
+		"legacy/keep/Dockerfile":             "",
+		"vendor/github.com/x/Dockerfile":     "",
+		"web/node_modules/pkg/Dockerfile":    "",
+		"web/Dockerfile.prod":                "",
+		"examples/Dockerfile":                "",
+		"examples/nested/demo.containerfile": "",
+	})
+	got, err := Walk(root, []string{"examples"})
+	if err != nil {
+		t.Fatalf("walk: %v", err)
+	}
+	var rel []string
+	for _, p := range got {
+		r, _ := filepath.Rel(root, p)
+		rel = append(rel, filepath.ToSlash(r))
+	}
+	want := []string{
+		"Dockerfile",
+		"legacy/keep/Dockerfile",
+		"svc/Containerfile",
+		"svc/api.Dockerfile",
+		"svc/nested/local.Dockerfile",
+		"web/Dockerfile.prod",
+	}
+	if !reflect.DeepEqual(rel, want) {
+		t.Fatalf("got %v want %v", rel, want)
+	}
+}
+
+// TestIntegrationWalkSkipsIgnoreFiles verifies that per-Dockerfile ignore files are not linted.
+func TestIntegrationWalkSkipsIgnoreFiles(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{"Dockerfile": "", "Dockerfile.dockerignore": ".git\n", ".dockerignore": ""})
+	got, err := Walk(root, nil)
+	if err != nil {
+		t.Fatalf("walk: %v", err)
+	}
+	if want := []string{filepath.Join(root, "Dockerfile")}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v want %v", got, want)
+	}
+}
+
+// TestExcluded verifies matching against relative paths and base names.
+func TestExcluded(t *testing.T) {
+	globs := []string{"**/testdata/**", "*.dev"}
+	if !Excluded("a/testdata/Dockerfile", globs) || !Excluded("x/Dockerfile.dev", globs) {
+		t.Fatalf("expected exclusion")
+	}
+	if Excluded("a/Dockerfile", globs) {
+		t.Fatalf("unexpected exclusion")
+	}
+}
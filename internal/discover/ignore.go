@@ -0,0 +1,130 @@
+// file: internal/discover/ignore.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package discover
+
+import (
+	"bufio"
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	doublestar "github.com/bmatcuk/doublestar/v4"
+)
+
+// ignoreRule is a single .gitignore pattern.
+//
+// base is the directory holding the ignore file, relative to the walk root.
+// Anchored patterns contain a slash and match paths relative to base; other
+// patterns match the base name at any depth.
+type ignoreRule struct {
+	base     string
+	pattern  string
+	negate   bool
+	dirOnly  bool
+	anchored bool
+}
+
+// ignoreList holds the rules in effect for a directory, outermost first.
+type ignoreList []ignoreRule
+
+// loadIgnores returns parent extended with the .gitignore and
+// .docker-lint-ignore rules of the directory dir, whose root-relative path is rel.
+func loadIgnores(dir, rel string, parent ignoreList) (ignoreList, error) {
+	list := parent
+	for _, name := range []string{".gitignore", IgnoreFile} {
+		rules, err := readIgnoreFile(filepath.Join(dir, name), rel)
+		if err != nil {
+			return nil, err
+		}
+		if len(rules) > 0 {
+			list = append(append(ignoreList(nil), list...), rules...)
+		}
+	}
+	return list, nil
+}
+
+// readIgnoreFile parses an ignore file; a missing file yields no rules.
+func readIgnoreFile(file, base string) (rules []ignoreRule, err error) {
+	f, err := os.Open(file)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil && cerr != nil {
+			err = cerr
+		}
+	}()
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		if r, ok := parseIgnoreLine(sc.Text(), base); ok {
+			rules = append(rules, r)
+		}
+	}
+	return rules, sc.Err()
+}
+
+// parseIgnoreLine parses one line of .gitignore syntax.
+func parseIgnoreLine(line, base string) (ignoreRule, bool) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return ignoreRule{}, false
+	}
+	r := ignoreRule{base: base}
+	if strings.HasPrefix(line, "!") {
+		r.negate = true
+		line = line[1:]
+	} else if strings.HasPrefix(line, `\`) {
+		line = line[1:]
+	}
+	if strings.HasSuffix(line, "/") {
+		r.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	if strings.Contains(line, "/") {
+		r.anchored = true
+		line = strings.TrimPrefix(line, "/")
+	}
+	if line == "" {
+		return ignoreRule{}, false
+	}
+	r.pattern = line
+	return r, true
+}
+
+// ignored reports whether the root-relative path rel is ignored.
+//
+// The last matching rule wins, so negated patterns re-include paths.
+func (l ignoreList) ignored(rel string, isDir bool) bool {
+	ignored := false
+	for _, r := range l {
+		if r.match(rel, isDir) {
+			ignored = !r.negate
+		}
+	}
+	return ignored
+}
+
+// match reports whether the rule matches rel.
+func (r ignoreRule) match(rel string, isDir bool) bool {
+	if r.dirOnly && !isDir {
+		return false
+	}
+	sub := rel
+	if r.base != "." {
+		if !strings.HasPrefix(rel, r.base+"/") {
+			return false
+		}
+		sub = strings.TrimPrefix(rel, r.base+"/")
+	}
+	if !r.anchored {
+		sub = path.Base(sub)
+	}
+	ok, _ := doublestar.Match(r.pattern, sub)
+	return ok
+}
@@ -0,0 +1,178 @@
+// file: internal/dockerignore/dockerignore.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+
+// Package dockerignore reads .dockerignore files and matches build context
+// paths against them.
+//
+// Patterns follow Docker's rules: comments and blank lines are skipped,
+// patterns are cleaned and made relative to the context root, * and ? do not
+// cross directory separators, ** matches any number of directories, and a
+// pattern matching a directory excludes everything below it. Patterns are
+// applied in order, so a later ! exception re-includes paths.
+package dockerignore
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	doublestar "github.com/bmatcuk/doublestar/v4"
+)
+
+// Name is the ignore file read from the root of the build context.
+const Name = ".dockerignore"
+
+// Pattern is one pattern of an ignore file.
+type Pattern struct {
+	// Text is the pattern as written, without surrounding whitespace.
+	Text string
+	// Line is the 1-based line of the pattern in the ignore file.
+	Line int
+	// Negate is set for ! exceptions that re-include matching paths.
+	Negate bool
+	// Glob is the cleaned pattern relative to the context root.
+	Glob string
+	// Err describes why the pattern is invalid; invalid patterns never match.
+	Err error
+}
+
+// File is a parsed ignore file.
+type File struct {
+	// Path is the location the file was read from.
+	Path string
+	// Patterns lists the patterns in file order.
+	Patterns []Pattern
+}
+
+// errEmpty reports an exception without a pattern.
+var errEmpty = errors.New("exception has no pattern")
+
+// errSyntax reports a pattern that cannot be compiled.
+var errSyntax = errors.New("invalid pattern syntax")
+
+// errOutside reports a pattern that can only match outside the context.
+var errOutside = errors.New("pattern refers outside the build context")
+
+// Parse parses ignore file content read from path.
+func Parse(path string, src []byte) *File {
+	f := &File{Path: path}
+	sc := bufio.NewScanner(bytes.NewReader(bytes.TrimPrefix(src, []byte("\xef\xbb\xbf"))))
+	line := 0
+	for sc.Scan() {
+		line++
+		text := sc.Text()
+		if strings.HasPrefix(text, "#") {
+			continue
+		}
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+		f.Patterns = append(f.Patterns, parsePattern(text, line))
+	}
+	return f
+}
+
+// parsePattern normalizes a pattern as Docker does and validates it.
+func parsePattern(text string, line int) Pattern {
+	p := Pattern{Text: text, Line: line}
+	glob := text
+	if strings.HasPrefix(glob, "!") {
+		p.Negate = true
+		glob = strings.TrimSpace(glob[1:])
+	}
+	if glob == "" {
+		p.Err = errEmpty
+		return p
+	}
+	glob = filepath.ToSlash(filepath.Clean(glob))
+	if len(glob) > 1 {
+		glob = strings.TrimPrefix(glob, "/")
+	}
+	p.Glob = glob
+	switch {
+	case glob == ".." || strings.HasPrefix(glob, "../"):
+		p.Err = errOutside
+	case !doublestar.ValidatePattern(escapeBraces(glob)):
+		p.Err = errSyntax
+	}
+	return p
+}
+
+// escapeBraces makes { and } literal, since Docker has no brace alternatives.
+func escapeBraces(glob string) string {
+	return strings.NewReplacer("{", `\{`, "}", `\}`).Replace(glob)
+}
+
+// Find returns the ignore file that applies to the Dockerfile at dockerfile
+// when building with context directory dir: <Dockerfile>.dockerignore next to
+// the Dockerfile takes precedence over .dockerignore in dir. It returns an
+// empty string when neither exists.
+func Find(dockerfile, dir string) (string, error) {
+	for _, p := range []string{dockerfile + Name, filepath.Join(dir, Name)} {
+		if _, err := os.Stat(p); err == nil {
+			return p, nil
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+	}
+	return "", nil
+}
+
+// Load reads the ignore file that applies to dockerfile within the context
+// dir, returning nil when there is none.
+func Load(dockerfile, dir string) (*File, error) {
+	p, err := Find(dockerfile, dir)
+	if err != nil || p == "" {
+		return nil, err
+	}
+	src, err := os.ReadFile(p)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(p, src), nil
+}
+
+// Excludes reports whether the context-relative path name is excluded from
+// the build context.
+func (f *File) Excludes(name string) bool {
+	if f == nil {
+		return false
+	}
+	name = path.Clean(strings.TrimPrefix(filepath.ToSlash(name), "/"))
+	excluded := false
+	for _, p := range f.Patterns {
+		// Only patterns that can change the outcome need to be matched.
+		if p.Err != nil || p.Negate != excluded {
+			continue
+		}
+		if p.Matches(name) {
+			excluded = !p.Negate
+		}
+	}
+	return excluded
+}
+
+// Matches reports whether the pattern matches the context-relative path name
+// or one of its parent directories.
+func (p Pattern) Matches(name string) bool {
+	if p.Err != nil {
+		return false
+	}
+	glob := escapeBraces(p.Glob)
+	for {
+		if ok, _ := doublestar.Match(glob, name); ok {
+			return true
+		}
+		parent := path.Dir(name)
+		if parent == name || parent == "." || parent == "/" {
+			return false
+		}
+		name = parent
+	}
+}
@@ -0,0 +1,87 @@
+// file: internal/dockerignore/dockerignore_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package dockerignore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestParse normalizes patterns and skips comments and blank lines.
+func TestParse(t *testing.T) {
+	f := Parse(".dockerignore", []byte("\xef\xbb\xbf# comment\n\n  /build/  \n! ./keep\n./a/../b\n"))
+	if len(f.Patterns) != 3 {
+		t.Fatalf("expected 3 patterns, got %#v", f.Patterns)
+	}
+	want := []Pattern{
+		{Text: "/build/", Line: 3, Glob: "build"},
+		{Text: "! ./keep", Line: 4, Negate: true, Glob: "keep"},
+		{Text: "./a/../b", Line: 5, Glob: "b"},
+	}
+	for i, w := range want {
+		if f.Patterns[i] != w {
+			t.Fatalf("pattern %d: got %#v want %#v", i, f.Patterns[i], w)
+		}
+	}
+}
+
+// TestParseInvalid reports patterns that can never match.
+func TestParseInvalid(t *testing.T) {
+	for _, src := range []string{"[abc", "!", "..", "../x", "a/[", "\\"} {
+		if p := Parse(".dockerignore", []byte(src)).Patterns; len(p) != 1 || p[0].Err == nil {
+			t.Fatalf("%q: expected an invalid pattern, got %#v", src, p)
+		}
+	}
+}
+
+// TestExcludes applies patterns in order, including parent directories and exceptions.
+func TestExcludes(t *testing.T) {
+	f := Parse(".dockerignore", []byte(".git\n*.pem\n**/*.key\nnode_modules\ndocs\n!docs/README.md\n{a,b}\n"))
+	cases := map[string]bool{
+		".git":                true,
+		".git/config":         true,
+		"key.pem":             true,
+		"certs/key.pem":       false,
+		"id.key":              true,
+		"deep/dir/id.key":     true,
+		"node_modules/x/y.js": true,
+		"docs/guide.md":       true,
+		"docs/README.md":      false,
+		"/docs/README.md":     false,
+		"src/main.go":         false,
+		"a":                   false,
+		"{a,b}":               true,
+	}
+	for p, want := range cases {
+		if got := f.Excludes(p); got != want {
+			t.Fatalf("%s: expected %v", p, want)
+		}
+	}
+	var none *File
+	if none.Excludes(".git") {
+		t.Fatalf("nil file must not exclude")
+	}
+}
+
+// TestLoad prefers <Dockerfile>.dockerignore over the context .dockerignore.
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	df := filepath.Join(dir, "Dockerfile")
+	if f, err := Load(df, dir); err != nil || f != nil {
+		t.Fatalf("expected no ignore file, got %v %v", f, err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, Name), []byte(".git\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if f, err := Load(df, dir); err != nil || f.Path != filepath.Join(dir, Name) || !f.Excludes(".git") {
+		t.Fatalf("expected the context ignore file, got %v %v", f, err)
+	}
+	if err := os.WriteFile(df+Name, []byte("node_modules\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := Load(df, dir)
+	if err != nil || f.Path != df+Name || f.Excludes(".git") || !f.Excludes("node_modules") {
+		t.Fatalf("expected the Dockerfile-specific ignore file, got %v %v", f, err)
+	}
+}
@@ -4,6 +4,12 @@ package engine
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
 	"github.com/asymmetric-effort/docker-lint/internal/ir"
 )
 
@@ -14,6 +20,8 @@ type Finding struct {
 	RuleID  string `json:"rule"`
 	Message string `json:"message"`
 	Line    int    `json:"line,omitempty"`
+	// Severity is set by rules with a configured severity, such as custom rules.
+	Severity string `json:"severity,omitempty"`
 }
 
 // Rule defines the interface for lint rules.
@@ -24,28 +32,95 @@ type Rule interface {
 	Check(ctx context.Context, d *ir.Document) ([]Finding, error)
 }
 
+// CheckIDs returns an error naming the first rule in rs whose ID, compared
+// case-insensitively as selection patterns are, repeats an earlier rule's.
+func CheckIDs(rs []Rule) error {
+	seen := make(map[string]struct{}, len(rs))
+	for _, r := range rs {
+		id := strings.ToUpper(r.ID())
+		if _, dup := seen[id]; dup {
+			return fmt.Errorf("duplicate rule %s", r.ID())
+		}
+		seen[id] = struct{}{}
+	}
+	return nil
+}
+
 // Registry stores and executes lint rules.
 //
 // Registry allows registration of rules and running them over a document.
+// Rules run sequentially unless concurrency is enabled with WithRuleConcurrency;
+// either way findings are returned in registration order.
 type Registry struct {
-	rules []Rule
+	rules       []Rule
+	concurrency int
+	ruleTimeout time.Duration
+}
+
+// Option configures a Registry.
+type Option func(*Registry)
+
+// WithRuleConcurrency runs up to n rules at once against a document.
+//
+// Values below 2 run rules sequentially.
+func WithRuleConcurrency(n int) Option {
+	return func(r *Registry) { r.concurrency = n }
+}
+
+// WithRuleTimeout bounds the time a single rule may spend checking a document.
+//
+// A rule that exceeds the timeout fails the run with an error wrapping
+// context.DeadlineExceeded. A zero duration disables the limit.
+func WithRuleTimeout(d time.Duration) Option {
+	return func(r *Registry) { r.ruleTimeout = d }
 }
 
 // NewRegistry creates an empty rule registry.
-func NewRegistry() *Registry { return &Registry{} }
+func NewRegistry(opts ...Option) *Registry {
+	r := &Registry{}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
 
 // Register adds a rule to the registry.
 func (r *Registry) Register(rule Rule) { r.rules = append(r.rules, rule) }
 
+// Rules returns the registered rules in registration order.
+func (r *Registry) Rules() []Rule { return append([]Rule(nil), r.rules...) }
+
+// IDs returns the identifiers of the registered rules in registration order.
+func (r *Registry) IDs() []string {
+	ids := make([]string, len(r.rules))
+	for i, rl := range r.rules {
+		ids[i] = rl.ID()
+	}
+	return ids
+}
+
 // Run executes all registered rules against the document.
+//
+// Run stops at the first rule error, or when ctx is cancelled, and returns
+// the error. Findings are ordered by rule registration, then by rule output.
 func (r *Registry) Run(ctx context.Context, d *ir.Document) ([]Finding, error) {
 	ignores := lineIgnores(d)
-	var all []Finding
-	for _, rl := range r.rules {
-		f, err := rl.Check(ctx, d)
-		if err != nil {
+	results := make([][]Finding, len(r.rules))
+	if r.concurrency > 1 {
+		if err := r.runConcurrent(ctx, d, results); err != nil {
 			return nil, err
 		}
+	} else {
+		for i, rl := range r.rules {
+			f, err := r.check(ctx, rl, d)
+			if err != nil {
+				return nil, err
+			}
+			results[i] = f
+		}
+	}
+	var all []Finding
+	for _, f := range results {
 		for _, fd := range f {
 			if shouldSkip(ignores, fd) {
 				continue
@@ -55,3 +130,72 @@ func (r *Registry) Run(ctx context.Context, d *ir.Document) ([]Finding, error) {
 	}
 	return all, nil
 }
+
+// runConcurrent checks rules on a bounded pool of goroutines, storing each
+// rule's findings at its registration index.
+func (r *Registry) runConcurrent(ctx context.Context, d *ir.Document, results [][]Finding) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	errs := make([]error, len(r.rules))
+	sem := make(chan struct{}, r.concurrency)
+	var wg sync.WaitGroup
+	for i, rl := range r.rules {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+			defer func() { <-sem }()
+			results[i], errs[i] = r.check(ctx, rl, d)
+			if errs[i] != nil {
+				cancel()
+			}
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil && !errors.Is(err, context.Canceled) {
+			return err
+		}
+	}
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// check runs a single rule, honoring cancellation and the per-rule timeout.
+//
+// Rules that ignore their context are abandoned when the deadline passes;
+// their late results are discarded.
+func (r *Registry) check(ctx context.Context, rl Rule, d *ir.Document) ([]Finding, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if r.ruleTimeout <= 0 {
+		return rl.Check(ctx, d)
+	}
+	ctx, cancel := context.WithTimeout(ctx, r.ruleTimeout)
+	defer cancel()
+	type result struct {
+		findings []Finding
+		err      error
+	}
+	done := make(chan result, 1)
+	go func() {
+		f, err := rl.Check(ctx, d)
+		done <- result{f, err}
+	}()
+	select {
+	case res := <-done:
+		return res.findings, res.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("rule %s: %w", rl.ID(), ctx.Err())
+	}
+}
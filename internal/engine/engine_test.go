@@ -5,8 +5,10 @@ package engine_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/moby/buildkit/frontend/dockerfile/parser"
 
@@ -27,6 +29,16 @@ func (s stubRule) Check(ctx context.Context, d *ir.Document) ([]engine.Finding,
 	return s.findings, s.err
 }
 
+// TestCheckIDs verifies that rule IDs must be unique regardless of case.
+func TestCheckIDs(t *testing.T) {
+	if err := engine.CheckIDs([]engine.Rule{stubRule{id: "A1"}, stubRule{id: "A2"}}); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := engine.CheckIDs([]engine.Rule{stubRule{id: "A1"}, stubRule{id: "a1"}}); err == nil || err.Error() != "duplicate rule a1" {
+		t.Fatalf("expected duplicate rule error, got %v", err)
+	}
+}
+
 // TestIntegrationRegistryRun verifies successful rule execution.
 func TestIntegrationRegistryRun(t *testing.T) {
 	r := engine.NewRegistry()
@@ -92,3 +104,98 @@ func TestIntegrationInlineIgnoreSameLine(t *testing.T) {
 		t.Fatalf("expected no findings, got %d", len(findings))
 	}
 }
+
+// TestIntegrationInlineIgnoreHeredoc ensures pragmas before a RUN cover findings in its heredoc body.
+func TestIntegrationInlineIgnoreHeredoc(t *testing.T) {
+	src := "FROM alpine:3.19\n# hadolint ignore=DL3018\nRUN <<EOF\necho start\napk add --no-cache curl\nEOF\n"
+	res, err := parser.Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	doc, err := ir.BuildDocument("Dockerfile", res.AST)
+	if err != nil {
+		t.Fatalf("build document: %v", err)
+	}
+	r := engine.NewRegistry()
+	r.Register(rules.NewApkPin())
+	findings, err := r.Run(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("run failed: %v", err)
+	}
+	if len(findings) != 0 {
+		t.Fatalf("expected no findings, got %#v", findings)
+	}
+}
+
+// slowRule blocks until its context is done or release is closed.
+type slowRule struct {
+	id      string
+	release chan struct{}
+}
+
+func (s slowRule) ID() string { return s.id }
+
+func (s slowRule) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	select {
+	case <-s.release:
+	case <-time.After(time.Second):
+	}
+	return []engine.Finding{{RuleID: s.id}}, nil
+}
+
+// TestIntegrationRegistryRunConcurrentOrder ensures concurrent rules report findings in registration order.
+func TestIntegrationRegistryRunConcurrentOrder(t *testing.T) {
+	r := engine.NewRegistry(engine.WithRuleConcurrency(4))
+	var want []string
+	for i := 0; i < 20; i++ {
+		id := fmt.Sprintf("R%02d", i)
+		want = append(want, id)
+		r.Register(stubRule{id: id, findings: []engine.Finding{{RuleID: id}}})
+	}
+	out, err := r.Run(context.Background(), &ir.Document{})
+	if err != nil {
+		t.Fatalf("run failed: %v", err)
+	}
+	if len(out) != len(want) {
+		t.Fatalf("expected %d findings, got %d", len(want), len(out))
+	}
+	for i, f := range out {
+		if f.RuleID != want[i] {
+			t.Fatalf("finding %d: got %s want %s", i, f.RuleID, want[i])
+		}
+	}
+}
+
+// TestIntegrationRegistryRunConcurrentError ensures rule errors propagate from concurrent runs.
+func TestIntegrationRegistryRunConcurrentError(t *testing.T) {
+	r := engine.NewRegistry(engine.WithRuleConcurrency(2))
+	r.Register(stubRule{id: "A"})
+	r.Register(stubRule{id: "B", err: errors.New("bad")})
+	if _, err := r.Run(context.Background(), &ir.Document{}); err == nil || err.Error() != "bad" {
+		t.Fatalf("expected rule error, got %v", err)
+	}
+}
+
+// TestIntegrationRegistryRunRuleTimeout ensures a rule exceeding its timeout fails the run.
+func TestIntegrationRegistryRunRuleTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	r := engine.NewRegistry(engine.WithRuleTimeout(10 * time.Millisecond))
+	r.Register(slowRule{id: "SLOW", release: release})
+	_, err := r.Run(context.Background(), &ir.Document{})
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "SLOW") {
+		t.Fatalf("expected deadline error naming the rule, got %v", err)
+	}
+}
+
+// TestIntegrationRegistryRunCancelled ensures a cancelled context stops the run.
+func TestIntegrationRegistryRunCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, r := range []*engine.Registry{engine.NewRegistry(), engine.NewRegistry(engine.WithRuleConcurrency(2))} {
+		r.Register(stubRule{id: "A"})
+		if _, err := r.Run(ctx, &ir.Document{}); !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	}
+}
@@ -16,18 +16,23 @@ const ignoreDirective = "hadolint ignore="
 // lineIgnores returns rule IDs to skip keyed by line number.
 //
 // lineIgnores scans the document's AST for `hadolint ignore=` pragmas and
-// records which rules should be skipped for each instruction line.
+// records which rules should be skipped for every line an instruction spans,
+// so findings reported inside continuation lines or heredoc bodies honor the
+// pragma preceding the instruction.
 func lineIgnores(d *ir.Document) map[int]map[string]struct{} {
 	m := make(map[int]map[string]struct{})
 	if d == nil || d.AST == nil {
 		return m
 	}
 	for _, n := range d.AST.Children {
-		line := n.StartLine
+		var ids []string
 		for _, com := range n.PrevComment {
-			addIgnores(m, line, parseIgnorePragma(com))
+			ids = append(ids, parseIgnorePragma(com)...)
+		}
+		ids = append(ids, parseIgnorePragma(n.Original)...)
+		for line := n.StartLine; line <= max(n.EndLine, n.StartLine); line++ {
+			addIgnores(m, line, ids)
 		}
-		addIgnores(m, line, parseIgnorePragma(n.Original))
 	}
 	return m
 }
@@ -0,0 +1,89 @@
+// file: internal/engine/metadata.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package engine
+
+import "strings"
+
+// Category groups rules by the concern they address.
+type Category string
+
+// Rule categories.
+const (
+	CategorySecurity          Category = "security"
+	CategoryMaintainability   Category = "maintainability"
+	CategoryPerformance       Category = "performance"
+	CategoryPackageManagement Category = "package-management"
+	CategoryLabels            Category = "labels"
+	CategoryMultiStage        Category = "multi-stage"
+)
+
+// Categories lists every rule category in display order.
+var Categories = []Category{
+	CategorySecurity,
+	CategoryMaintainability,
+	CategoryPerformance,
+	CategoryPackageManagement,
+	CategoryLabels,
+	CategoryMultiStage,
+}
+
+// Severities, from most to least severe.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityInfo    = "info"
+	SeverityStyle   = "style"
+)
+
+// Metadata describes a rule for catalogs, reports and rule selection.
+//
+// Fixable reports whether violations can be corrected mechanically, without
+// judgment about the intent of the Dockerfile. OptIn marks rules that encode
+// a policy choice rather than a universal best practice; they only run when
+// enabled explicitly.
+type Metadata struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description,omitempty"`
+	Category    Category `json:"category,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	Severity    string   `json:"severity"`
+	Fixable     bool     `json:"fixable"`
+	OptIn       bool     `json:"optIn"`
+	DocsURL     string   `json:"docsUrl,omitempty"`
+}
+
+// Describer is implemented by rules that provide metadata.
+//
+// Implementing Describer is optional; Describe supplies defaults for rules
+// that do not.
+type Describer interface {
+	Metadata() Metadata
+}
+
+// Describe returns the metadata of r.
+//
+// Rules that do not implement Describer are titled by their ID, and a missing
+// severity defaults to warning.
+func Describe(r Rule) Metadata {
+	var m Metadata
+	if d, ok := r.(Describer); ok {
+		m = d.Metadata()
+	}
+	if m.Title == "" {
+		m.Title = r.ID()
+	}
+	if m.Severity == "" {
+		m.Severity = SeverityWarning
+	}
+	return m
+}
+
+// ParseCategory returns the category named s, matched case-insensitively.
+func ParseCategory(s string) (Category, bool) {
+	for _, c := range Categories {
+		if strings.EqualFold(string(c), s) {
+			return c, true
+		}
+	}
+	return "", false
+}
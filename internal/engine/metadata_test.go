@@ -0,0 +1,110 @@
+// file: internal/engine/metadata_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// describedRule is a rule with fixed metadata.
+type describedRule struct {
+	id   string
+	meta Metadata
+}
+
+func (r describedRule) ID() string         { return r.id }
+func (r describedRule) Metadata() Metadata { return r.meta }
+func (r describedRule) Check(context.Context, *ir.Document) ([]Finding, error) {
+	return nil, nil
+}
+
+// plainRule is a rule without metadata.
+type plainRule struct{}
+
+func (plainRule) ID() string                                             { return "PLAIN" }
+func (plainRule) Check(context.Context, *ir.Document) ([]Finding, error) { return nil, nil }
+
+// TestDescribeDefaults verifies that rules without metadata get a title and severity.
+func TestDescribeDefaults(t *testing.T) {
+	m := Describe(plainRule{})
+	if m.Title != "PLAIN" || m.Severity != SeverityWarning || m.Category != "" {
+		t.Fatalf("unexpected metadata %+v", m)
+	}
+	m = Describe(describedRule{id: "X", meta: Metadata{Title: "Title", Severity: SeverityError, Category: CategorySecurity}})
+	if m.Title != "Title" || m.Severity != SeverityError || m.Category != CategorySecurity {
+		t.Fatalf("unexpected metadata %+v", m)
+	}
+}
+
+// TestParseCategory verifies case-insensitive category lookup.
+func TestParseCategory(t *testing.T) {
+	if c, ok := ParseCategory("Package-Management"); !ok || c != CategoryPackageManagement {
+		t.Fatalf("got %q, %v", c, ok)
+	}
+	if _, ok := ParseCategory("speed"); ok {
+		t.Fatal("expected unknown category")
+	}
+}
+
+// TestSelectionSelects verifies category inclusion and exclusion.
+func TestSelectionSelects(t *testing.T) {
+	sec := describedRule{id: "S", meta: Metadata{Category: CategorySecurity}}
+	lbl := describedRule{id: "L", meta: Metadata{Category: CategoryLabels}}
+	cases := []struct {
+		sel  Selection
+		rule Rule
+		want bool
+	}{
+		{Selection{}, plainRule{}, true},
+		{Selection{Categories: []Category{CategorySecurity}}, sec, true},
+		{Selection{Categories: []Category{CategorySecurity}}, lbl, false},
+		{Selection{Categories: []Category{CategorySecurity}}, plainRule{}, false},
+		{Selection{ExcludeCategories: []Category{CategoryLabels}}, lbl, false},
+		{Selection{ExcludeCategories: []Category{CategoryLabels}}, plainRule{}, true},
+		{Selection{Categories: []Category{CategorySecurity}, ExcludeCategories: []Category{CategorySecurity}}, sec, false},
+	}
+	for i, c := range cases {
+		if got := c.sel.Selects(c.rule); got != c.want {
+			t.Errorf("case %d: Selects(%s) = %v; want %v", i, c.rule.ID(), got, c.want)
+		}
+	}
+}
+
+// TestSelectionPatterns verifies opt-in rules and the enable, only and disable patterns.
+func TestSelectionPatterns(t *testing.T) {
+	def := describedRule{id: "DL3007"}
+	opt := describedRule{id: "DL3057", meta: Metadata{OptIn: true}}
+	cases := []struct {
+		sel  Selection
+		rule Rule
+		want bool
+	}{
+		{Selection{}, def, true},
+		{Selection{}, opt, false},
+		{Selection{Enable: []string{"dl305*"}}, opt, true},
+		{Selection{Enable: []string{"DL3057"}, Disable: []string{"DL30*"}}, opt, false},
+		{Selection{Only: []string{"DL3057"}}, opt, true},
+		{Selection{Only: []string{"DL3057"}}, def, false},
+		{Selection{Only: []string{"DL300?"}}, def, true},
+		{Selection{Disable: []string{"DL3007"}}, def, false},
+	}
+	for i, c := range cases {
+		if got := c.sel.Selects(c.rule); got != c.want {
+			t.Errorf("case %d: Selects(%s) = %v; want %v", i, c.rule.ID(), got, c.want)
+		}
+	}
+	sel := Selection{Enable: []string{"DL3057"}, Only: []string{"DL9*"}}
+	if got := sel.Unmatched([]Rule{def, opt}); len(got) != 1 || got[0] != "DL9*" {
+		t.Fatalf("unexpected unmatched patterns %v", got)
+	}
+	if err := (Selection{Disable: []string{"DL["}}).Validate(); err == nil {
+		t.Fatal("expected invalid pattern error")
+	}
+	merged := Selection{Enable: []string{"A"}}.Merge(Selection{Enable: []string{"B"}, Disable: []string{"C"}})
+	if len(merged.Enable) != 2 || !merged.Disabled("c") {
+		t.Fatalf("unexpected merge %+v", merged)
+	}
+}
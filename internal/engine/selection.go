@@ -0,0 +1,100 @@
+// file: internal/engine/selection.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package engine
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+)
+
+// Selection chooses which rules run.
+//
+// Rule ID patterns use path.Match syntax, such as DL30*, and match case
+// insensitively. Rules run by default unless their metadata marks them opt-in;
+// Enable adds opt-in rules, Only replaces the default set with the matching
+// rules, and Disable and the category filters remove rules from either. The
+// zero Selection runs every rule that is not opt-in.
+type Selection struct {
+	// Enable lists patterns of opt-in rules to run.
+	Enable []string
+	// Only, when non-empty, runs just the rules matching these patterns, opt-in rules included.
+	Only []string
+	// Disable lists patterns of rules that never run.
+	Disable []string
+	// Categories, when non-empty, restricts selection to rules in these categories.
+	Categories []Category
+	// ExcludeCategories drops rules in these categories.
+	ExcludeCategories []Category
+}
+
+// MatchID reports whether the rule ID pattern matches id.
+//
+// A malformed pattern matches nothing; Validate reports it.
+func MatchID(pattern, id string) bool {
+	ok, err := path.Match(strings.ToUpper(pattern), strings.ToUpper(id))
+	return err == nil && ok
+}
+
+// matchAny reports whether any of patterns matches id.
+func matchAny(patterns []string, id string) bool {
+	return slices.ContainsFunc(patterns, func(p string) bool { return MatchID(p, id) })
+}
+
+// Validate reports the first malformed pattern.
+func (s Selection) Validate() error {
+	for _, p := range slices.Concat(s.Enable, s.Only, s.Disable) {
+		if _, err := path.Match(p, ""); err != nil {
+			return fmt.Errorf("invalid rule pattern %q", p)
+		}
+	}
+	return nil
+}
+
+// Merge returns the selection combining the patterns and categories of s and o.
+func (s Selection) Merge(o Selection) Selection {
+	return Selection{
+		Enable:            slices.Concat(s.Enable, o.Enable),
+		Only:              slices.Concat(s.Only, o.Only),
+		Disable:           slices.Concat(s.Disable, o.Disable),
+		Categories:        slices.Concat(s.Categories, o.Categories),
+		ExcludeCategories: slices.Concat(s.ExcludeCategories, o.ExcludeCategories),
+	}
+}
+
+// Disabled reports whether findings of rule id are suppressed by Disable.
+func (s Selection) Disabled(id string) bool { return matchAny(s.Disable, id) }
+
+// Selects reports whether s selects r.
+//
+// A rule without a category is not selected once Categories is non-empty.
+func (s Selection) Selects(r Rule) bool {
+	id := r.ID()
+	if s.Disabled(id) {
+		return false
+	}
+	m := Describe(r)
+	if slices.Contains(s.ExcludeCategories, m.Category) {
+		return false
+	}
+	if len(s.Categories) > 0 && !slices.Contains(s.Categories, m.Category) {
+		return false
+	}
+	if len(s.Only) > 0 {
+		return matchAny(s.Only, id)
+	}
+	return !m.OptIn || matchAny(s.Enable, id)
+}
+
+// Unmatched returns the Enable and Only patterns that match none of rs,
+// which usually indicates a misspelled rule ID.
+func (s Selection) Unmatched(rs []Rule) []string {
+	var out []string
+	for _, p := range slices.Concat(s.Enable, s.Only) {
+		if !slices.ContainsFunc(rs, func(r Rule) bool { return MatchID(p, r.ID()) }) {
+			out = append(out, p)
+		}
+	}
+	return out
+}
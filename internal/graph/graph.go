@@ -0,0 +1,160 @@
+// file: internal/graph/graph.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+
+// Package graph renders the stage dependency graph of a Dockerfile.
+package graph
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// Format selects the graph output syntax.
+type Format string
+
+const (
+	// FormatDOT renders Graphviz DOT.
+	FormatDOT Format = "dot"
+	// FormatMermaid renders a Mermaid flowchart.
+	FormatMermaid Format = "mermaid"
+)
+
+// Write renders the stage graph of doc to w in the requested format.
+func Write(w io.Writer, doc *ir.Document, format Format) error {
+	switch format {
+	case FormatDOT, "":
+		return WriteDOT(w, doc)
+	case FormatMermaid:
+		return WriteMermaid(w, doc)
+	default:
+		return fmt.Errorf("unknown graph format %q", format)
+	}
+}
+
+// WriteDOT renders the stage graph of doc as a Graphviz digraph.
+//
+// Stages are boxes labelled with their name and base image, external images
+// are dashed ellipses, the target stage is drawn with a double border and
+// stages the target never reaches are greyed out.
+func WriteDOT(w io.Writer, doc *ir.Document) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", dotQuote(doc.Filepath))
+	b.WriteString("  rankdir=LR;\n")
+	for _, st := range doc.Stages {
+		attrs := []string{"shape=box", "label=" + dotQuote(stageName(st)+"\n"+st.From)}
+		if st.Index == doc.Target {
+			attrs = append(attrs, "peripheries=2")
+		}
+		if !st.Reachable {
+			attrs = append(attrs, "style=dashed", "color=gray", "fontcolor=gray")
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", dotQuote(stageID(st.Index)), strings.Join(attrs, ", "))
+	}
+	images := externalImages(doc)
+	for i, img := range images {
+		fmt.Fprintf(&b, "  %s [shape=ellipse, style=dashed, label=%s];\n", dotQuote(imageID(i)), dotQuote(img))
+	}
+	forEachEdge(doc, images, func(src, dst string, kind ir.EdgeKind) {
+		fmt.Fprintf(&b, "  %s -> %s [label=%s];\n", dotQuote(src), dotQuote(dst), dotQuote(string(kind)))
+	})
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteMermaid renders the stage graph of doc as a Mermaid flowchart.
+//
+// Stages are rectangles, external images are stadium-shaped nodes, and the
+// target and unreachable stages are highlighted with class definitions.
+func WriteMermaid(w io.Writer, doc *ir.Document) error {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	for _, st := range doc.Stages {
+		fmt.Fprintf(&b, "  %s[\"%s<br/>%s\"]\n", stageID(st.Index), mermaidEscape(stageName(st)), mermaidEscape(st.From))
+	}
+	images := externalImages(doc)
+	for i, img := range images {
+		fmt.Fprintf(&b, "  %s([\"%s\"])\n", imageID(i), mermaidEscape(img))
+	}
+	forEachEdge(doc, images, func(src, dst string, kind ir.EdgeKind) {
+		fmt.Fprintf(&b, "  %s -->|%s| %s\n", src, mermaidEscape(string(kind)), dst)
+	})
+	b.WriteString("  classDef target stroke-width:3px\n")
+	b.WriteString("  classDef unreachable stroke-dasharray:5 5,color:#888\n")
+	for _, st := range doc.Stages {
+		switch {
+		case st.Index == doc.Target:
+			fmt.Fprintf(&b, "  class %s target\n", stageID(st.Index))
+		case !st.Reachable:
+			fmt.Fprintf(&b, "  class %s unreachable\n", stageID(st.Index))
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// forEachEdge calls fn with node identifiers for every stage edge in document order.
+func forEachEdge(doc *ir.Document, images []string, fn func(src, dst string, kind ir.EdgeKind)) {
+	imageIdx := make(map[string]int, len(images))
+	for i, img := range images {
+		imageIdx[img] = i
+	}
+	for _, st := range doc.Stages {
+		for _, e := range st.Edges {
+			src := stageID(e.Stage)
+			if e.Stage < 0 {
+				src = imageID(imageIdx[e.Ref])
+			}
+			fn(src, stageID(st.Index), e.Kind)
+		}
+	}
+}
+
+// externalImages returns the distinct external image references in first-seen order.
+func externalImages(doc *ir.Document) []string {
+	var images []string
+	seen := map[string]struct{}{}
+	for _, st := range doc.Stages {
+		for _, e := range st.Edges {
+			if e.Stage >= 0 {
+				continue
+			}
+			if _, ok := seen[e.Ref]; ok {
+				continue
+			}
+			seen[e.Ref] = struct{}{}
+			images = append(images, e.Ref)
+		}
+	}
+	return images
+}
+
+// stageName returns the stage alias, or a positional name for unnamed stages.
+func stageName(st *ir.Stage) string {
+	if st.Name != "" {
+		return st.Name
+	}
+	return "stage " + strconv.Itoa(st.Index)
+}
+
+// stageID returns the node identifier for a stage index.
+func stageID(i int) string { return "s" + strconv.Itoa(i) }
+
+// imageID returns the node identifier for an external image index.
+func imageID(i int) string { return "i" + strconv.Itoa(i) }
+
+// dotQuote returns s as a quoted DOT identifier.
+func dotQuote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + r.Replace(s) + `"`
+}
+
+// mermaidEscape replaces characters that terminate Mermaid labels with entity codes.
+func mermaidEscape(s string) string {
+	r := strings.NewReplacer(`"`, "#quot;", "|", "#124;", "<", "#lt;", ">", "#gt;")
+	return r.Replace(s)
+}
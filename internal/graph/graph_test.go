@@ -0,0 +1,96 @@
+// file: internal/graph/graph_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package graph
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+const graphSrc = "FROM golang:1.22 AS build\n" +
+	"RUN go build\n" +
+	"FROM alpine:3.19 AS unused\n" +
+	"FROM alpine:3.19\n" +
+	"COPY --from=build /out /out\n" +
+	"COPY --from=nginx:1.25 /etc/nginx /etc/nginx\n"
+
+// buildGraphDocument parses graphSrc into a Document.
+func buildGraphDocument(t *testing.T) *ir.Document {
+	t.Helper()
+	res, err := parser.Parse(strings.NewReader(graphSrc))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	doc, err := ir.BuildDocument("Dockerfile", res.AST)
+	if err != nil {
+		t.Fatalf("build document: %v", err)
+	}
+	return doc
+}
+
+// TestIntegrationWriteDOT verifies stages, images and edges in DOT output.
+func TestIntegrationWriteDOT(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, buildGraphDocument(t), FormatDOT); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		`digraph "Dockerfile" {`,
+		`"s0" [shape=box, label="build\ngolang:1.22"];`,
+		`"s1" [shape=box, label="unused\nalpine:3.19", style=dashed, color=gray, fontcolor=gray];`,
+		`"s2" [shape=box, label="stage 2\nalpine:3.19", peripheries=2];`,
+		`"i0" [shape=ellipse, style=dashed, label="golang:1.22"];`,
+		`"i2" [shape=ellipse, style=dashed, label="nginx:1.25"];`,
+		`"s0" -> "s2" [label="COPY --from"];`,
+		`"i2" -> "s2" [label="COPY --from"];`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("missing %q in output:\n%s", want, out)
+		}
+	}
+}
+
+// TestIntegrationWriteMermaid verifies Mermaid flowchart output.
+func TestIntegrationWriteMermaid(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, buildGraphDocument(t), FormatMermaid); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"flowchart LR\n",
+		`s0["build<br/>golang:1.22"]`,
+		`i1(["alpine:3.19"])`,
+		"i0 -->|FROM| s0",
+		"s0 -->|COPY --from| s2",
+		"class s2 target",
+		"class s1 unreachable",
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("missing %q in output:\n%s", want, out)
+		}
+	}
+}
+
+// TestWriteUnknownFormat ensures unsupported formats are rejected.
+func TestWriteUnknownFormat(t *testing.T) {
+	if err := Write(&bytes.Buffer{}, &ir.Document{}, "svg"); err == nil {
+		t.Fatalf("expected error for unknown format")
+	}
+}
+
+// TestEscaping covers DOT quoting and Mermaid entity escaping.
+func TestEscaping(t *testing.T) {
+	if got := dotQuote("a\"b\\c\nd"); got != `"a\"b\\c\nd"` {
+		t.Fatalf("unexpected dot quoting: %s", got)
+	}
+	if got := mermaidEscape(`x"|<>`); got != "x#quot;#124;#lt;#gt;" {
+		t.Fatalf("unexpected mermaid escaping: %s", got)
+	}
+}
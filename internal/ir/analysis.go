@@ -0,0 +1,237 @@
+// file: internal/ir/analysis.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package ir
+
+import (
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/shell"
+)
+
+// Script is a shell script executed by a RUN instruction or a HEALTHCHECK.
+//
+// Line is the Dockerfile line corresponding to line 1 of the script.
+type Script struct {
+	List *shell.List
+	Line int
+}
+
+// Command is a simple command executed by a RUN instruction.
+//
+// Argv excludes environment prefix assignments and redirections. Line is the
+// Dockerfile line on which the command starts.
+type Command struct {
+	Argv []string
+	Line int
+}
+
+// Label is a key-value pair declared by a LABEL instruction.
+type Label struct {
+	Key   string
+	Value string
+	Node  *parser.Node
+}
+
+// analysis memoizes views of a Document that many rules derive.
+//
+// Every view is computed on first use and shared by later callers, so the cost
+// of tokenizing RUN scripts or scanning the AST is paid once per document
+// rather than once per rule. The mutex makes the cache safe for rules that run
+// concurrently.
+type analysis struct {
+	mu        sync.Mutex
+	byKeyword map[string][]*parser.Node
+	stageOf   map[*parser.Node]*Stage
+	scripts   map[*parser.Node][]Script
+	commands  map[*parser.Node][]Command
+	labels    []Label
+	labelsSet bool
+}
+
+// Instructions returns the instructions with the given keyword, case-insensitively, in source order.
+func (d *Document) Instructions(keyword string) []*parser.Node {
+	if d == nil || d.AST == nil {
+		return nil
+	}
+	d.analysis.mu.Lock()
+	defer d.analysis.mu.Unlock()
+	if d.analysis.byKeyword == nil {
+		d.analysis.byKeyword = make(map[string][]*parser.Node)
+		for _, n := range d.AST.Children {
+			kw := strings.ToLower(n.Value)
+			d.analysis.byKeyword[kw] = append(d.analysis.byKeyword[kw], n)
+		}
+	}
+	return d.analysis.byKeyword[strings.ToLower(keyword)]
+}
+
+// StageOf returns the stage containing an instruction, or nil when the
+// instruction precedes the first FROM or is not part of the document.
+func (d *Document) StageOf(n *parser.Node) *Stage {
+	if d == nil || n == nil {
+		return nil
+	}
+	d.analysis.mu.Lock()
+	defer d.analysis.mu.Unlock()
+	if d.analysis.stageOf == nil {
+		d.analysis.stageOf = make(map[*parser.Node]*Stage)
+		for _, st := range d.Stages {
+			if st.Node != nil {
+				d.analysis.stageOf[st.Node] = st
+			}
+			for _, in := range st.Instructions {
+				d.analysis.stageOf[in] = st
+			}
+		}
+	}
+	return d.analysis.stageOf[n]
+}
+
+// RunScripts returns the shell scripts executed by a RUN instruction.
+//
+// A HEALTHCHECK instruction yields the script of its CMD, parsed the same way,
+// and none for HEALTHCHECK NONE.
+//
+// Shell-form instructions are parsed with the shell parser, along with every
+// heredoc body executed by a shell. A RUN whose command line is only a heredoc
+// marker runs the body itself, so the command line is not parsed. Exec-form
+// instructions that invoke a shell with -c are parsed from the script
+// argument; other exec-form instructions yield a single simple command.
+// Malformed scripts yield the commands the parser could still recognize.
+//
+// Results are memoized per instruction; a nil Document parses without caching.
+func (d *Document) RunScripts(n *parser.Node) []Script {
+	if d == nil {
+		return runScripts(n)
+	}
+	d.analysis.mu.Lock()
+	defer d.analysis.mu.Unlock()
+	return d.runScriptsLocked(n)
+}
+
+// RunCommands returns every simple command executed by a RUN instruction.
+//
+// Commands nested in subshells, compound commands, command substitutions and
+// heredoc scripts are included. Results are memoized per instruction; a nil
+// Document parses without caching.
+func (d *Document) RunCommands(n *parser.Node) []Command {
+	if d == nil {
+		return scriptCommands(runScripts(n))
+	}
+	d.analysis.mu.Lock()
+	defer d.analysis.mu.Unlock()
+	if cmds, ok := d.analysis.commands[n]; ok {
+		return cmds
+	}
+	if d.analysis.commands == nil {
+		d.analysis.commands = make(map[*parser.Node][]Command)
+	}
+	cmds := scriptCommands(d.runScriptsLocked(n))
+	d.analysis.commands[n] = cmds
+	return cmds
+}
+
+// Labels returns every LABEL key-value pair in source order.
+func (d *Document) Labels() []Label {
+	if d == nil || d.AST == nil {
+		return nil
+	}
+	labels := d.Instructions("label")
+	d.analysis.mu.Lock()
+	defer d.analysis.mu.Unlock()
+	if !d.analysis.labelsSet {
+		for _, n := range labels {
+			d.analysis.labels = append(d.analysis.labels, LabelPairs(n)...)
+		}
+		d.analysis.labelsSet = true
+	}
+	return d.analysis.labels
+}
+
+// LabelPairs extracts the key-value pairs of a LABEL instruction, trimming quotes.
+func LabelPairs(n *parser.Node) []Label {
+	var pairs []Label
+	if n == nil {
+		return pairs
+	}
+	var tokens []string
+	for tok := n.Next; tok != nil; tok = tok.Next {
+		tokens = append(tokens, strings.Trim(tok.Value, "\"'"))
+	}
+	for i := 0; i+2 < len(tokens); i += 3 {
+		pairs = append(pairs, Label{Key: tokens[i], Value: tokens[i+1], Node: n})
+	}
+	return pairs
+}
+
+// runScriptsLocked returns the memoized scripts of n; the caller holds the mutex.
+func (d *Document) runScriptsLocked(n *parser.Node) []Script {
+	if scripts, ok := d.analysis.scripts[n]; ok {
+		return scripts
+	}
+	if d.analysis.scripts == nil {
+		d.analysis.scripts = make(map[*parser.Node][]Script)
+	}
+	scripts := runScripts(n)
+	d.analysis.scripts[n] = scripts
+	return scripts
+}
+
+// runScripts parses the shell scripts executed by a RUN instruction or the
+// CMD of a HEALTHCHECK.
+func runScripts(n *parser.Node) []Script {
+	if n == nil || n.Next == nil {
+		return nil
+	}
+	if strings.EqualFold(n.Value, "healthcheck") {
+		// The parser emits the CMD keyword as the first argument.
+		if !strings.EqualFold(n.Next.Value, "cmd") || n.Next.Next == nil {
+			return nil
+		}
+		n = &parser.Node{Value: n.Value, Next: n.Next.Next, Attributes: n.Attributes, StartLine: n.StartLine}
+	}
+	if n.Attributes == nil || !n.Attributes["json"] {
+		var scripts []Script
+		if !HeredocOnly(n) {
+			list, _ := shell.Parse(n.Next.Value)
+			scripts = append(scripts, Script{List: list, Line: n.StartLine})
+		}
+		for _, h := range Heredocs(n) {
+			if h.Script {
+				list, _ := shell.Parse(h.Content)
+				scripts = append(scripts, Script{List: list, Line: h.Line})
+			}
+		}
+		return scripts
+	}
+	cmd := &shell.SimpleCommand{Line: 1}
+	for tok := n.Next; tok != nil; tok = tok.Next {
+		cmd.Args = append(cmd.Args, &shell.Word{Raw: tok.Value, Value: tok.Value})
+	}
+	argv := cmd.Argv()
+	if _, ok := shellNames[path.Base(argv[0])]; ok {
+		for i := 1; i+1 < len(argv); i++ {
+			if argv[i] == "-c" {
+				list, _ := shell.Parse(argv[i+1])
+				return []Script{{List: list, Line: n.StartLine}}
+			}
+		}
+	}
+	list := &shell.List{Items: []*shell.AndOr{{Pipelines: []*shell.Pipeline{{Cmds: []shell.Command{cmd}}}}}}
+	return []Script{{List: list, Line: n.StartLine}}
+}
+
+// scriptCommands flattens scripts into simple commands with Dockerfile lines.
+func scriptCommands(scripts []Script) []Command {
+	var out []Command
+	for _, s := range scripts {
+		for _, c := range shell.Commands(s.List) {
+			out = append(out, Command{Argv: c.Argv(), Line: s.Line + c.Line - 1})
+		}
+	}
+	return out
+}
@@ -0,0 +1,108 @@
+// file: internal/ir/analysis_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package ir
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+)
+
+// TestRunCommands covers exec-form shells, nested commands and assignments.
+func TestRunCommands(t *testing.T) {
+	var d *Document
+	exec := &parser.Node{Attributes: map[string]bool{"json": true}, Next: &parser.Node{Value: "/bin/bash", Next: &parser.Node{Value: "-c", Next: &parser.Node{Value: "A=1 make && (cd x; ls)"}}}}
+	want := [][]string{{"make"}, {"cd", "x"}, {"ls"}}
+	var got [][]string
+	for _, c := range d.RunCommands(exec) {
+		got = append(got, c.Argv)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v want %v", got, want)
+	}
+	plain := &parser.Node{Attributes: map[string]bool{"json": true}, Next: &parser.Node{Value: "echo", Next: &parser.Node{Value: "hi"}}}
+	if cmds := d.RunCommands(plain); len(cmds) != 1 || !reflect.DeepEqual(cmds[0].Argv, []string{"echo", "hi"}) {
+		t.Fatalf("unexpected exec form commands %v", cmds)
+	}
+	if d.RunCommands(nil) != nil {
+		t.Fatalf("expected nil for nil node")
+	}
+}
+
+// TestRunCommandsHealthcheck parses the CMD of HEALTHCHECK instructions.
+func TestRunCommandsHealthcheck(t *testing.T) {
+	var d *Document
+	cases := map[string][][]string{
+		"HEALTHCHECK --interval=5s CMD curl -f http://localhost/ || exit 1\n": {{"curl", "-f", "http://localhost/"}, {"exit", "1"}},
+		"HEALTHCHECK CMD [\"/bin/sh\", \"-c\", \"wget -q -O- localhost\"]\n":  {{"wget", "-q", "-O-", "localhost"}},
+		"HEALTHCHECK CMD [\"/healthcheck\"]\n":                                {{"/healthcheck"}},
+		"HEALTHCHECK NONE\n":                                                  nil,
+	}
+	for src, want := range cases {
+		res, err := parser.Parse(strings.NewReader(src))
+		if err != nil {
+			t.Fatalf("parse: %v", err)
+		}
+		var got [][]string
+		for _, c := range d.RunCommands(res.AST.Children[0]) {
+			got = append(got, c.Argv)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("%q: got %v want %v", src, got, want)
+		}
+	}
+}
+
+// TestIntegrationAnalysisMemoizes verifies derived views are computed once and shared.
+func TestIntegrationAnalysisMemoizes(t *testing.T) {
+	src := "FROM alpine:3.19 AS build\n" +
+		"RUN apk add --no-cache make && \\\n  make\n" +
+		"LABEL a=1 b=\"two\"\n" +
+		"FROM scratch\n" +
+		"RUN <<EOF\necho hi\nEOF\n" +
+		"label c=3\n"
+	doc := buildTestDocument(t, src)
+	runs := doc.Instructions("RUN")
+	if len(runs) != 2 || len(doc.Instructions("run")) != 2 || doc.Instructions("copy") != nil {
+		t.Fatalf("unexpected RUN instructions: %v", runs)
+	}
+	first := doc.RunCommands(runs[0])
+	if len(first) != 2 || first[1].Argv[0] != "make" || first[1].Line != 2 {
+		t.Fatalf("unexpected commands: %+v", first)
+	}
+	if again := doc.RunCommands(runs[0]); &again[0] != &first[0] {
+		t.Fatalf("expected memoized commands")
+	}
+	if heredoc := doc.RunCommands(runs[1]); len(heredoc) != 1 || heredoc[0].Line != 7 {
+		t.Fatalf("unexpected heredoc commands: %+v", heredoc)
+	}
+	if st := doc.StageOf(runs[1]); st == nil || st.Index != 1 {
+		t.Fatalf("expected second stage, got %+v", st)
+	}
+	if st := doc.StageOf(doc.Stages[0].Node); st != doc.Stages[0] {
+		t.Fatalf("expected FROM to belong to its stage")
+	}
+	labels := doc.Labels()
+	want := []Label{{Key: "a", Value: "1"}, {Key: "b", Value: "two"}, {Key: "c", Value: "3"}}
+	if len(labels) != len(want) {
+		t.Fatalf("expected %d labels, got %+v", len(want), labels)
+	}
+	for i, w := range want {
+		if labels[i].Key != w.Key || labels[i].Value != w.Value || labels[i].Node == nil {
+			t.Fatalf("label %d: got %+v want %+v", i, labels[i], w)
+		}
+	}
+}
+
+// TestNilDocumentAnalysis ensures analysis helpers tolerate a nil document.
+func TestNilDocumentAnalysis(t *testing.T) {
+	var d *Document
+	if d.Instructions("run") != nil || d.StageOf(&parser.Node{}) != nil || d.Labels() != nil || d.RunScripts(nil) != nil {
+		t.Fatalf("expected empty results for nil document")
+	}
+	if pairs := LabelPairs(nil); len(pairs) != 0 {
+		t.Fatalf("expected no pairs, got %d", len(pairs))
+	}
+}
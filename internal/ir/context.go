@@ -0,0 +1,37 @@
+// file: internal/ir/context.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package ir
+
+import (
+	"path/filepath"
+
+	"github.com/asymmetric-effort/docker-lint/internal/dockerignore"
+)
+
+// Context is the build context of a Dockerfile.
+type Context struct {
+	// Dir is the context directory.
+	Dir string
+	// Explicit reports whether Dir was given by the user rather than assumed
+	// to be the Dockerfile's directory. Source files are only checked against
+	// explicit contexts.
+	Explicit bool
+	// Ignore holds the applicable .dockerignore file, or nil when there is none.
+	Ignore *dockerignore.File
+}
+
+// LoadContext returns the build context of the Dockerfile at dockerfile with
+// context directory dir, along with its .dockerignore file. An empty dir
+// stands for the Dockerfile's directory.
+func LoadContext(dockerfile, dir string) (*Context, error) {
+	c := &Context{Dir: dir, Explicit: dir != ""}
+	if !c.Explicit {
+		c.Dir = filepath.Dir(dockerfile)
+	}
+	ignore, err := dockerignore.Load(dockerfile, c.Dir)
+	if err != nil {
+		return nil, err
+	}
+	c.Ignore = ignore
+	return c, nil
+}
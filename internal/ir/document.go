@@ -10,29 +10,50 @@ import (
 
 // Document is a normalized representation of a Dockerfile.
 //
-// Document retains stage information extracted from the Dockerfile AST.
+// Document retains stage information extracted from the Dockerfile AST along
+// with the stage dependency graph and the index of the build target stage.
+// Derived views such as RUN commands and LABEL pairs are memoized on first use;
+// a Document must not be copied after use.
 type Document struct {
 	Filepath string
 	Stages   []*Stage
 	AST      *parser.Node
+	// Target is the index of the stage being built, or -1 when the document has no stages.
+	Target int
+	// Context is the build context on disk, or nil when the Dockerfile was not read from a file.
+	Context *Context
+
+	analysis analysis
 }
 
 // Stage represents a single FROM instruction.
 //
 // Stage records the source image, optional name, and AST node for positioning.
+// Instructions holds the nodes following FROM up to the next stage. Parent is
+// the index of the stage named by FROM, or -1 for an external image. Deps lists
+// every stage this stage consumes via FROM, COPY --from or RUN --mount=from=,
+// while Edges records each of those references, including external images.
+// Reachable reports whether building the target stage requires this stage.
 type Stage struct {
-	Index int
-	Name  string
-	From  string
-	Node  *parser.Node
+	Index        int
+	Name         string
+	From         string
+	Node         *parser.Node
+	Instructions []*parser.Node
+	Parent       int
+	Deps         []int
+	Edges        []Edge
+	Reachable    bool
 }
 
 // BuildDocument converts an AST into a Document.
 //
-// BuildDocument iterates the AST, collecting FROM instructions as stages.
+// BuildDocument iterates the AST, collecting FROM instructions as stages, then
+// resolves stage dependencies and marks the final stage as the build target.
 func BuildDocument(path string, ast *parser.Node) (*Document, error) {
-	doc := &Document{Filepath: path, AST: ast}
+	doc := &Document{Filepath: path, AST: ast, Target: -1}
 	idx := 0
+	var current *Stage
 	for _, n := range ast.Children {
 		if strings.EqualFold(n.Value, "from") {
 			from := ""
@@ -46,9 +67,17 @@ func BuildDocument(path string, ast *parser.Node) (*Document, error) {
 					}
 				}
 			}
-			doc.Stages = append(doc.Stages, &Stage{Index: idx, Name: name, From: from, Node: n})
+			current = &Stage{Index: idx, Name: name, From: from, Node: n, Parent: -1}
+			doc.Stages = append(doc.Stages, current)
 			idx++
+			continue
+		}
+		if current != nil {
+			current.Instructions = append(current.Instructions, n)
 		}
 	}
+	doc.linkStages()
+	doc.Target = len(doc.Stages) - 1
+	doc.markReachable()
 	return doc, nil
 }
@@ -3,6 +3,7 @@
 package ir
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
@@ -25,3 +26,87 @@ func BenchmarkBuildDocument(b *testing.B) {
 		}
 	}
 }
+
+// benchmarkRuleCount approximates the number of rules that inspect RUN commands.
+const benchmarkRuleCount = 64
+
+// largeDockerfile returns a multi-stage Dockerfile with many RUN and LABEL instructions.
+func largeDockerfile(b *testing.B) *parser.Node {
+	b.Helper()
+	var sb strings.Builder
+	for s := 0; s < 4; s++ {
+		fmt.Fprintf(&sb, "FROM alpine:3.19 AS stage%d\n", s)
+		for i := 0; i < 100; i++ {
+			fmt.Fprintf(&sb, "RUN apk add --no-cache pkg%d=1.0 && \\\n    if [ -f /etc/x ]; then echo $(cat /etc/x) | tee /tmp/%d; fi\n", i, i)
+			fmt.Fprintf(&sb, "LABEL org.example.k%d=\"v%d\" other=%d\n", i, i, i)
+		}
+	}
+	res, err := parser.Parse(strings.NewReader(sb.String()))
+	if err != nil {
+		b.Fatalf("parse failed: %v", err)
+	}
+	return res.AST
+}
+
+// BenchmarkRunCommandsUncached simulates every rule tokenizing each RUN instruction itself.
+func BenchmarkRunCommandsUncached(b *testing.B) {
+	ast := largeDockerfile(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var d *Document
+		for r := 0; r < benchmarkRuleCount; r++ {
+			for _, n := range ast.Children {
+				if strings.EqualFold(n.Value, "run") {
+					_ = d.RunCommands(n)
+				}
+			}
+		}
+	}
+}
+
+// BenchmarkRunCommandsMemoized simulates every rule sharing the document's analysis cache.
+func BenchmarkRunCommandsMemoized(b *testing.B) {
+	ast := largeDockerfile(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d, err := BuildDocument("Dockerfile", ast)
+		if err != nil {
+			b.Fatalf("build document: %v", err)
+		}
+		for r := 0; r < benchmarkRuleCount; r++ {
+			for _, n := range d.Instructions("run") {
+				_ = d.RunCommands(n)
+			}
+		}
+	}
+}
+
+// BenchmarkLabelsUncached simulates every label rule extracting pairs itself.
+func BenchmarkLabelsUncached(b *testing.B) {
+	ast := largeDockerfile(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for r := 0; r < benchmarkRuleCount; r++ {
+			for _, n := range ast.Children {
+				if strings.EqualFold(n.Value, "label") {
+					_ = LabelPairs(n)
+				}
+			}
+		}
+	}
+}
+
+// BenchmarkLabelsMemoized simulates every label rule sharing the document's label pairs.
+func BenchmarkLabelsMemoized(b *testing.B) {
+	ast := largeDockerfile(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d, err := BuildDocument("Dockerfile", ast)
+		if err != nil {
+			b.Fatalf("build document: %v", err)
+		}
+		for r := 0; r < benchmarkRuleCount; r++ {
+			_ = d.Labels()
+		}
+	}
+}
@@ -0,0 +1,177 @@
+// file: internal/ir/graph.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package ir
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+)
+
+// TargetStage returns the stage being built, or nil when the document has no stages.
+func (d *Document) TargetStage() *Stage {
+	if d == nil || d.Target < 0 || d.Target >= len(d.Stages) {
+		return nil
+	}
+	return d.Stages[d.Target]
+}
+
+// SetTarget selects the named stage as the build target and recomputes reachability.
+//
+// SetTarget accepts a stage name (case-insensitive) or a numeric stage index,
+// mirroring `docker build --target`. An empty name restores the final stage.
+func (d *Document) SetTarget(name string) error {
+	if name == "" {
+		d.Target = len(d.Stages) - 1
+		d.markReachable()
+		return nil
+	}
+	st := d.StageByRef(name, len(d.Stages))
+	if st == nil {
+		return fmt.Errorf("target stage %q not found", name)
+	}
+	d.Target = st.Index
+	d.markReachable()
+	return nil
+}
+
+// StageByRef resolves a stage reference among stages preceding index before.
+//
+// A reference is either a stage name, matched case-insensitively, or a numeric
+// stage index. StageByRef returns nil when the reference names an external image.
+func (d *Document) StageByRef(ref string, before int) *Stage {
+	if d == nil || ref == "" {
+		return nil
+	}
+	if before > len(d.Stages) {
+		before = len(d.Stages)
+	}
+	if i, err := strconv.Atoi(ref); err == nil {
+		if i >= 0 && i < before {
+			return d.Stages[i]
+		}
+		return nil
+	}
+	for i := before - 1; i >= 0; i-- {
+		if d.Stages[i].Name != "" && strings.EqualFold(d.Stages[i].Name, ref) {
+			return d.Stages[i]
+		}
+	}
+	return nil
+}
+
+// EdgeKind identifies the instruction that creates a stage dependency.
+type EdgeKind string
+
+const (
+	// EdgeFrom is a FROM base image or stage.
+	EdgeFrom EdgeKind = "FROM"
+	// EdgeCopy is a COPY --from source.
+	EdgeCopy EdgeKind = "COPY --from"
+	// EdgeMount is a RUN --mount=from= source.
+	EdgeMount EdgeKind = "RUN --mount"
+)
+
+// Edge is a reference from a stage to another stage or an external image.
+//
+// Stage holds the index of the referenced stage, or -1 when Ref names an
+// external image. Node is the instruction that created the reference.
+type Edge struct {
+	Kind  EdgeKind
+	Ref   string
+	Stage int
+	Node  *parser.Node
+}
+
+// linkStages resolves FROM, COPY --from and RUN --mount=from= references into stage dependencies.
+func (d *Document) linkStages() {
+	for _, st := range d.Stages {
+		seen := map[int]struct{}{}
+		add := func(kind EdgeKind, ref string, n *parser.Node) *Stage {
+			var dep *Stage
+			// FROM only accepts stage names; a numeric value is an image reference.
+			if _, err := strconv.Atoi(ref); kind != EdgeFrom || err != nil {
+				dep = d.StageByRef(ref, st.Index)
+			}
+			e := Edge{Kind: kind, Ref: ref, Stage: -1, Node: n}
+			if dep != nil {
+				e.Stage = dep.Index
+				if _, ok := seen[dep.Index]; !ok {
+					seen[dep.Index] = struct{}{}
+					st.Deps = append(st.Deps, dep.Index)
+				}
+			}
+			st.Edges = append(st.Edges, e)
+			return dep
+		}
+		if parent := add(EdgeFrom, st.From, st.Node); parent != nil {
+			st.Parent = parent.Index
+		}
+		for _, n := range st.Instructions {
+			kind := EdgeCopy
+			if strings.EqualFold(n.Value, "run") {
+				kind = EdgeMount
+			}
+			for _, ref := range StageRefs(n) {
+				add(kind, ref, n)
+			}
+		}
+	}
+}
+
+// markReachable flags every stage the target stage transitively depends on.
+func (d *Document) markReachable() {
+	for _, st := range d.Stages {
+		st.Reachable = false
+	}
+	target := d.TargetStage()
+	if target == nil {
+		return
+	}
+	stack := []*Stage{target}
+	for len(stack) > 0 {
+		st := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if st.Reachable {
+			continue
+		}
+		st.Reachable = true
+		for _, dep := range st.Deps {
+			stack = append(stack, d.Stages[dep])
+		}
+	}
+}
+
+// StageRefs returns the stage or image references consumed by an instruction.
+//
+// StageRefs reports the value of COPY --from and the from= option of every
+// RUN --mount flag. Quoting around values is removed.
+func StageRefs(n *parser.Node) []string {
+	if n == nil {
+		return nil
+	}
+	var refs []string
+	switch strings.ToLower(n.Value) {
+	case "copy":
+		for _, f := range n.Flags {
+			if strings.HasPrefix(strings.ToLower(f), "--from=") {
+				refs = append(refs, strings.Trim(f[len("--from="):], "\"'"))
+			}
+		}
+	case "run":
+		for _, f := range n.Flags {
+			if !strings.HasPrefix(strings.ToLower(f), "--mount=") {
+				continue
+			}
+			for _, opt := range strings.Split(f[len("--mount="):], ",") {
+				k, v, ok := strings.Cut(opt, "=")
+				if ok && strings.EqualFold(strings.TrimSpace(k), "from") {
+					refs = append(refs, strings.Trim(v, "\"'"))
+				}
+			}
+		}
+	}
+	return refs
+}
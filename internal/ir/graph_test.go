@@ -0,0 +1,110 @@
+// file: internal/ir/graph_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package ir
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+)
+
+// buildTestDocument parses src and builds a Document for graph tests.
+func buildTestDocument(t *testing.T, src string) *Document {
+	t.Helper()
+	res, err := parser.Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	doc, err := BuildDocument("Dockerfile", res.AST)
+	if err != nil {
+		t.Fatalf("build document: %v", err)
+	}
+	return doc
+}
+
+// TestIntegrationStageGraph verifies dependency edges from FROM, COPY --from and RUN --mount.
+func TestIntegrationStageGraph(t *testing.T) {
+	src := "FROM golang:1.22 AS build\n" +
+		"RUN go build\n" +
+		"FROM alpine:3.19 AS assets\n" +
+		"FROM build AS test\n" +
+		"RUN --mount=type=bind,from=assets,target=/a go test\n" +
+		"FROM alpine:3.19\n" +
+		"COPY --from=0 /out /out\n" +
+		"COPY --from=nginx:1.25 /etc/nginx /etc/nginx\n"
+	doc := buildTestDocument(t, src)
+	if len(doc.Stages) != 4 {
+		t.Fatalf("expected 4 stages, got %d", len(doc.Stages))
+	}
+	test := doc.Stages[2]
+	if test.Parent != 0 || !reflect.DeepEqual(test.Deps, []int{0, 1}) {
+		t.Fatalf("unexpected test stage links: parent=%d deps=%v", test.Parent, test.Deps)
+	}
+	final := doc.Stages[3]
+	if final.Parent != -1 || !reflect.DeepEqual(final.Deps, []int{0}) {
+		t.Fatalf("unexpected final stage links: parent=%d deps=%v", final.Parent, final.Deps)
+	}
+	if len(final.Edges) != 3 || final.Edges[2].Kind != EdgeCopy || final.Edges[2].Stage != -1 || final.Edges[2].Ref != "nginx:1.25" {
+		t.Fatalf("unexpected final stage edges: %+v", final.Edges)
+	}
+	if e := test.Edges[1]; e.Kind != EdgeMount || e.Stage != 1 {
+		t.Fatalf("unexpected mount edge: %+v", e)
+	}
+	if len(final.Instructions) != 2 {
+		t.Fatalf("expected 2 instructions in final stage, got %d", len(final.Instructions))
+	}
+	if doc.Target != 3 || doc.TargetStage() != final {
+		t.Fatalf("expected final stage as target, got %d", doc.Target)
+	}
+	want := []bool{true, false, false, true}
+	for i, st := range doc.Stages {
+		if st.Reachable != want[i] {
+			t.Fatalf("stage %d: expected reachable=%v", i, want[i])
+		}
+	}
+}
+
+// TestIntegrationSetTarget verifies target selection and reachability updates.
+func TestIntegrationSetTarget(t *testing.T) {
+	doc := buildTestDocument(t, "FROM alpine AS base\nFROM base AS Test\nFROM scratch\n")
+	if err := doc.SetTarget("test"); err != nil {
+		t.Fatalf("set target: %v", err)
+	}
+	if doc.Target != 1 || !doc.Stages[0].Reachable || doc.Stages[2].Reachable {
+		t.Fatalf("unexpected reachability after SetTarget")
+	}
+	if err := doc.SetTarget("missing"); err == nil {
+		t.Fatalf("expected error for unknown target")
+	}
+	if err := doc.SetTarget(""); err != nil || doc.Target != 2 {
+		t.Fatalf("expected final stage restored, got %d %v", doc.Target, err)
+	}
+}
+
+// TestStageByRefForwardReference ensures references only resolve to earlier stages.
+func TestStageByRefForwardReference(t *testing.T) {
+	doc := buildTestDocument(t, "FROM alpine AS a\nCOPY --from=b /x /x\nFROM alpine AS b\n")
+	if len(doc.Stages[0].Deps) != 0 {
+		t.Fatalf("expected forward reference to be ignored, got %v", doc.Stages[0].Deps)
+	}
+	if doc.StageByRef("b", 2) != doc.Stages[1] || doc.StageByRef("5", 2) != nil {
+		t.Fatalf("unexpected StageByRef resolution")
+	}
+}
+
+// TestStageRefs covers COPY and RUN reference extraction.
+func TestStageRefs(t *testing.T) {
+	cp := &parser.Node{Value: "copy", Flags: []string{"--chown=app", "--from=\"build\""}}
+	if got := StageRefs(cp); !reflect.DeepEqual(got, []string{"build"}) {
+		t.Fatalf("unexpected copy refs: %v", got)
+	}
+	run := &parser.Node{Value: "RUN", Flags: []string{"--mount=type=cache,target=/c", "--mount=type=bind,from=deps,target=/d"}}
+	if got := StageRefs(run); !reflect.DeepEqual(got, []string{"deps"}) {
+		t.Fatalf("unexpected run refs: %v", got)
+	}
+	if StageRefs(nil) != nil {
+		t.Fatalf("expected nil refs for nil node")
+	}
+}
@@ -0,0 +1,141 @@
+// file: internal/ir/heredoc.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package ir
+
+import (
+	"path"
+	"strings"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/shell"
+)
+
+// defaultShell is the interpreter BuildKit uses for heredoc scripts without a shebang.
+const defaultShell = "/bin/sh"
+
+// shellNames lists interpreters whose input is a POSIX-like shell script.
+var shellNames = map[string]struct{}{
+	"sh": {}, "bash": {}, "ash": {}, "dash": {}, "zsh": {}, "ksh": {},
+}
+
+// Heredoc is a here-document attached to a RUN, COPY or ADD instruction.
+//
+// Line is the Dockerfile line holding the first line of Content. Interpreter
+// names the program that reads Content: the shebang interpreter, or the default
+// shell, for a RUN whose command line is only the heredoc, otherwise the
+// command reading the document on standard input. Interpreter is empty for COPY
+// and ADD, where the document is file content. Script reports whether Content
+// is executed as a shell script.
+type Heredoc struct {
+	Name        string
+	Content     string
+	Expand      bool
+	Chomp       bool
+	Line        int
+	Interpreter string
+	Script      bool
+}
+
+// Heredocs returns the here-documents of an instruction in source order.
+func Heredocs(n *parser.Node) []Heredoc {
+	if n == nil || len(n.Heredocs) == 0 {
+		return nil
+	}
+	out := make([]Heredoc, len(n.Heredocs))
+	line := n.EndLine
+	for i := len(n.Heredocs) - 1; i >= 0; i-- {
+		h := n.Heredocs[i]
+		line -= strings.Count(h.Content, "\n")
+		out[i] = Heredoc{Name: h.Name, Content: h.Content, Expand: h.Expand, Chomp: h.Chomp, Line: line}
+		line--
+	}
+	if !strings.EqualFold(n.Value, "run") || n.Next == nil {
+		return out
+	}
+	if HeredocOnly(n) {
+		out[0].Interpreter = shebangInterpreter(out[0].Content)
+		if out[0].Interpreter == "" {
+			out[0].Interpreter = defaultShell
+		}
+		out[0].Script = isShell(out[0].Interpreter)
+		return out
+	}
+	list, _ := shell.Parse(n.Next.Value)
+	for _, c := range shell.Commands(list) {
+		stdin := -1
+		for _, r := range c.Redirs {
+			if r.Op != "<<" && r.Op != "<<-" {
+				continue
+			}
+			for i := range out {
+				if out[i].Name != r.Target.Value || out[i].Interpreter != "" {
+					continue
+				}
+				out[i].Interpreter = c.Name()
+				if r.N == "" || r.N == "0" {
+					stdin = i
+				}
+				break
+			}
+		}
+		if stdin >= 0 {
+			out[stdin].Script = readsScriptFromStdin(c.Argv())
+		}
+	}
+	return out
+}
+
+// HeredocOnly reports whether a RUN instruction's command line is a single
+// heredoc marker, in which case BuildKit executes the heredoc body directly.
+func HeredocOnly(n *parser.Node) bool {
+	if n == nil || n.Next == nil || len(n.Heredocs) != 1 || !strings.EqualFold(n.Value, "run") {
+		return false
+	}
+	marker := strings.TrimSpace(n.Next.Value)
+	marker = strings.TrimPrefix(marker, "<<")
+	marker = strings.TrimPrefix(marker, "-")
+	return strings.Trim(marker, `"'`) == n.Heredocs[0].Name
+}
+
+// shebangInterpreter returns the program named by a script's shebang line.
+//
+// `#!/usr/bin/env prog` yields prog. Scripts without a shebang yield an empty string.
+func shebangInterpreter(content string) string {
+	first, _, _ := strings.Cut(content, "\n")
+	if !strings.HasPrefix(first, "#!") {
+		return ""
+	}
+	fields := strings.Fields(strings.TrimPrefix(first, "#!"))
+	if len(fields) == 0 {
+		return ""
+	}
+	if path.Base(fields[0]) != "env" {
+		return fields[0]
+	}
+	for _, f := range fields[1:] {
+		if !strings.HasPrefix(f, "-") && !strings.Contains(f, "=") {
+			return f
+		}
+	}
+	return ""
+}
+
+// readsScriptFromStdin reports whether argv runs a shell that executes standard input.
+func readsScriptFromStdin(argv []string) bool {
+	if len(argv) == 0 || !isShell(argv[0]) {
+		return false
+	}
+	for _, a := range argv[1:] {
+		if !strings.HasPrefix(a, "-") || a == "-c" {
+			return false
+		}
+	}
+	return true
+}
+
+// isShell reports whether the program is a POSIX-like shell.
+func isShell(prog string) bool {
+	_, ok := shellNames[path.Base(prog)]
+	return ok
+}
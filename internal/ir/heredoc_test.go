@@ -0,0 +1,61 @@
+// file: internal/ir/heredoc_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package ir
+
+import (
+	"testing"
+)
+
+// TestIntegrationHeredocs verifies heredoc lines, interpreters and script detection.
+func TestIntegrationHeredocs(t *testing.T) {
+	src := "FROM alpine:3.19\n" +
+		"RUN <<EOF\napk add curl\nls\nEOF\n" +
+		"RUN <<PY\n#!/usr/bin/env python3\nprint(1)\nPY\n" +
+		"RUN bash -e <<A\nls\nA\n" +
+		"RUN cat <<A > /etc/motd && python3 <<B\nhello\nA\nprint(2)\nB\n" +
+		"COPY <<F /x\nhi\nF\n"
+	doc := buildTestDocument(t, src)
+	cases := []struct {
+		node int
+		want []Heredoc
+	}{
+		{1, []Heredoc{{Name: "EOF", Line: 3, Interpreter: "/bin/sh", Script: true}}},
+		{2, []Heredoc{{Name: "PY", Line: 7, Interpreter: "python3"}}},
+		{3, []Heredoc{{Name: "A", Line: 11, Interpreter: "bash", Script: true}}},
+		{4, []Heredoc{{Name: "A", Line: 14, Interpreter: "cat"}, {Name: "B", Line: 16, Interpreter: "python3"}}},
+		{5, []Heredoc{{Name: "F", Line: 19}}},
+	}
+	for _, c := range cases {
+		got := Heredocs(doc.AST.Children[c.node])
+		if len(got) != len(c.want) {
+			t.Fatalf("node %d: expected %d heredocs, got %+v", c.node, len(c.want), got)
+		}
+		for i, w := range c.want {
+			g := got[i]
+			if g.Name != w.Name || g.Line != w.Line || g.Interpreter != w.Interpreter || g.Script != w.Script {
+				t.Fatalf("node %d heredoc %d: got %+v want %+v", c.node, i, g, w)
+			}
+		}
+	}
+	if !HeredocOnly(doc.AST.Children[1]) || HeredocOnly(doc.AST.Children[3]) || HeredocOnly(doc.AST.Children[5]) {
+		t.Fatalf("unexpected HeredocOnly result")
+	}
+	if Heredocs(doc.AST.Children[0]) != nil || Heredocs(nil) != nil {
+		t.Fatalf("expected nil heredocs")
+	}
+}
+
+// TestShebangInterpreter covers direct and env-based shebangs.
+func TestShebangInterpreter(t *testing.T) {
+	cases := map[string]string{
+		"#!/bin/bash\nls\n":              "/bin/bash",
+		"#!/usr/bin/env -S python3 -u\n": "python3",
+		"#!\n":                           "",
+		"ls\n":                           "",
+	}
+	for in, want := range cases {
+		if got := shebangInterpreter(in); got != want {
+			t.Fatalf("shebangInterpreter(%q) = %q; want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+// file: internal/ir/image.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package ir
+
+import "strings"
+
+// DefaultRegistry is the registry of image references that name none.
+const DefaultRegistry = "docker.io"
+
+// ImageRef is a parsed image reference such as ghcr.io/org/app:1.2@sha256:….
+//
+// Registry is DefaultRegistry when the reference names none, and empty for
+// scratch. Tag and Digest are empty when absent; Digest includes its
+// algorithm prefix.
+type ImageRef struct {
+	Registry   string
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+// ParseImageRef splits an image reference into its components.
+//
+// The first path component is a registry when it contains a dot or a colon
+// or is localhost, following the Docker reference grammar.
+func ParseImageRef(ref string) ImageRef {
+	var r ImageRef
+	if i := strings.Index(ref, "@"); i >= 0 {
+		ref, r.Digest = ref[:i], ref[i+1:]
+	}
+	if i := strings.LastIndex(ref, ":"); i >= 0 && !strings.Contains(ref[i:], "/") {
+		ref, r.Tag = ref[:i], ref[i+1:]
+	}
+	if first, rest, ok := strings.Cut(ref, "/"); ok && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		r.Registry, ref = first, rest
+	} else if !strings.EqualFold(ref, "scratch") {
+		r.Registry = DefaultRegistry
+	}
+	r.Repository = ref
+	return r
+}
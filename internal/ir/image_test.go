@@ -0,0 +1,22 @@
+// file: internal/ir/image_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package ir
+
+import "testing"
+
+// TestParseImageRef covers registries, ports, tags and digests.
+func TestParseImageRef(t *testing.T) {
+	cases := map[string]ImageRef{
+		"alpine":                           {Registry: "docker.io", Repository: "alpine"},
+		"library/alpine:3.20":              {Registry: "docker.io", Repository: "library/alpine", Tag: "3.20"},
+		"ghcr.io/acme/base-go:1@sha256:ab": {Registry: "ghcr.io", Repository: "acme/base-go", Tag: "1", Digest: "sha256:ab"},
+		"localhost:5000/app":               {Registry: "localhost:5000", Repository: "app"},
+		"localhost/app@sha256:cd":          {Registry: "localhost", Repository: "app", Digest: "sha256:cd"},
+		"scratch":                          {Repository: "scratch"},
+	}
+	for ref, want := range cases {
+		if got := ParseImageRef(ref); got != want {
+			t.Fatalf("%s: got %+v want %+v", ref, got, want)
+		}
+	}
+}
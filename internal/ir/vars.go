@@ -0,0 +1,150 @@
+// file: internal/ir/vars.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package ir
+
+import (
+	"strings"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+	dfshell "github.com/moby/buildkit/frontend/dockerfile/shell"
+)
+
+// scope tracks the build variables in effect while walking a stage.
+//
+// ENV values take precedence over ARG values of the same name, as in Docker.
+type scope struct {
+	vars map[string]string
+	env  map[string]struct{}
+}
+
+// newScope returns an empty scope.
+func newScope() *scope {
+	return &scope{vars: map[string]string{}, env: map[string]struct{}{}}
+}
+
+// Get implements shell.EnvGetter.
+func (s *scope) Get(key string) (string, bool) {
+	v, ok := s.vars[key]
+	return v, ok
+}
+
+// Keys implements shell.EnvGetter.
+func (s *scope) Keys() []string {
+	keys := make([]string, 0, len(s.vars))
+	for k := range s.vars {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// expand substitutes variables in word, reporting whether every reference was known.
+func (s *scope) expand(word string) (string, bool) {
+	out, unmatched, err := dfshell.NewLex('\\').ProcessWord(word, s)
+	if err != nil {
+		return word, false
+	}
+	return out, len(unmatched) == 0
+}
+
+// apply updates the scope with the ARG or ENV instruction n.
+//
+// global holds the values of ARGs declared before the first FROM, which an
+// ARG without a default re-imports. ARGs whose value is only known at build
+// time are left undefined.
+func (s *scope) apply(n *parser.Node, global map[string]string) {
+	switch strings.ToLower(n.Value) {
+	case "arg":
+		for tok := n.Next; tok != nil; tok = tok.Next {
+			key, val, hasVal := strings.Cut(tok.Value, "=")
+			if _, isEnv := s.env[key]; isEnv {
+				continue
+			}
+			if hasVal {
+				s.vars[key], _ = s.expand(val)
+			} else if v, ok := global[key]; ok {
+				s.vars[key] = v
+			} else {
+				delete(s.vars, key)
+			}
+		}
+	case "env":
+		// The parser emits each pair as key, value and separator nodes.
+		for tok := n.Next; tok != nil && tok.Next != nil; {
+			s.vars[tok.Value], _ = s.expand(tok.Next.Value)
+			s.env[tok.Value] = struct{}{}
+			if tok = tok.Next.Next; tok != nil {
+				tok = tok.Next
+			}
+		}
+	}
+}
+
+// globalArgs returns the ARG values declared before the first FROM.
+func (d *Document) globalArgs() map[string]string {
+	s := newScope()
+	if d == nil || d.AST == nil {
+		return s.vars
+	}
+	for _, n := range d.AST.Children {
+		if strings.EqualFold(n.Value, "from") {
+			break
+		}
+		if strings.EqualFold(n.Value, "arg") {
+			s.apply(n, nil)
+		}
+	}
+	return s.vars
+}
+
+// stageScope returns the scope of st just before instruction stop runs; a nil
+// stop yields the scope after the stage's last instruction.
+//
+// ENV values are inherited from the stage named by FROM; ARGs are not.
+func (d *Document) stageScope(st *Stage, stop *parser.Node, global map[string]string) *scope {
+	s := newScope()
+	if st.Parent >= 0 && st.Parent < st.Index {
+		parent := d.stageScope(d.Stages[st.Parent], nil, global)
+		for k := range parent.env {
+			s.vars[k] = parent.vars[k]
+			s.env[k] = struct{}{}
+		}
+	}
+	for _, n := range st.Instructions {
+		if n == stop {
+			break
+		}
+		s.apply(n, global)
+	}
+	return s
+}
+
+// Vars returns the ARG and ENV values in scope when instruction n runs.
+//
+// FROM instructions and instructions before the first FROM see the global
+// ARGs. Variables whose value is supplied only at build time are omitted.
+func (d *Document) Vars(n *parser.Node) map[string]string {
+	global := d.globalArgs()
+	st := d.StageOf(n)
+	if st == nil || n == st.Node {
+		return global
+	}
+	return d.stageScope(st, n, global).vars
+}
+
+// StageVars returns the ARG and ENV values in scope at the end of st.
+func (d *Document) StageVars(st *Stage) map[string]string {
+	if d == nil || st == nil {
+		return map[string]string{}
+	}
+	return d.stageScope(st, nil, d.globalArgs()).vars
+}
+
+// Expand substitutes the variables in scope at instruction n into word.
+//
+// ok is false when word references a variable without a known value; such
+// references expand to the empty string.
+func (d *Document) Expand(n *parser.Node, word string) (string, bool) {
+	s := newScope()
+	s.vars = d.Vars(n)
+	return s.expand(word)
+}
@@ -0,0 +1,52 @@
+// file: internal/ir/vars_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package ir
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestIntegrationVars verifies ARG and ENV scoping across stages.
+func TestIntegrationVars(t *testing.T) {
+	src := "ARG VERSION=3.20 PORT\n" +
+		"FROM alpine:${VERSION} AS base\n" +
+		"ARG VERSION\n" +
+		"ENV HOME=/srv APP=\"$HOME/app\"\n" +
+		"ENV LEGACY value with spaces\n" +
+		"ARG HOME=/ignored\n" +
+		"ARG PORT\n" +
+		"EXPOSE $PORT\n" +
+		"FROM base\n" +
+		"ARG LOCAL=1\n" +
+		"RUN echo $APP\n"
+	doc := buildTestDocument(t, src)
+	from := doc.Stages[0].Node
+	if got, ok := doc.Expand(from, from.Next.Value); !ok || got != "alpine:3.20" {
+		t.Fatalf("unexpected FROM expansion %q %v", got, ok)
+	}
+	want := map[string]string{"VERSION": "3.20", "HOME": "/srv", "APP": "/srv/app", "LEGACY": "value with spaces"}
+	if got := doc.StageVars(doc.Stages[0]); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v want %v", got, want)
+	}
+	expose := doc.Instructions("expose")[0]
+	if _, ok := doc.Expand(expose, expose.Next.Value); ok {
+		t.Fatalf("expected build-time ARG to be unknown")
+	}
+	run := doc.Instructions("run")[0]
+	want = map[string]string{"HOME": "/srv", "APP": "/srv/app", "LEGACY": "value with spaces", "LOCAL": "1"}
+	if got := doc.Vars(run); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v want %v", got, want)
+	}
+	if got := doc.Vars(doc.Instructions("arg")[1]); len(got) != 0 {
+		t.Fatalf("expected scope before instruction, got %v", got)
+	}
+}
+
+// TestNilDocumentVars verifies variable lookups on a nil document.
+func TestNilDocumentVars(t *testing.T) {
+	var d *Document
+	if len(d.Vars(nil)) != 0 || len(d.StageVars(nil)) != 0 {
+		t.Fatalf("expected no variables")
+	}
+}
@@ -0,0 +1,345 @@
+// file: internal/plugin/plugin.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+
+// Package plugin runs lint rules implemented by external executables.
+//
+// A plugin is spawned once per request. docker-lint writes a single JSON
+// Request to its standard input and reads a single JSON Response from its
+// standard output:
+//
+//   - {"version":1,"method":"describe"} is sent when the plugin is loaded and
+//     is answered with the rules the plugin implements, which are registered
+//     and listed like built-in rules.
+//   - {"version":1,"method":"check","document":{...}} carries the Document
+//     being linted and is answered with findings for the described rules.
+//
+// Each request is bounded by the plugin's timeout. A plugin that exits with a
+// non-zero status, times out, writes invalid JSON or reports an undeclared
+// rule does not abort linting; the failure is reported as a finding with the
+// rule ID "plugin/<name>". Responses are cached by document content, so all
+// rules of a plugin share one invocation per distinct document.
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/asymmetric-effort/docker-lint/internal/config"
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// DefaultTimeout bounds a plugin request when the configuration sets no timeout.
+const DefaultTimeout = 10 * time.Second
+
+// FailurePrefix prefixes the rule ID of findings that report a plugin failure.
+const FailurePrefix = "plugin/"
+
+// maxStderr is the number of trailing stderr bytes quoted in failure messages.
+const maxStderr = 512
+
+// maxCached is the number of documents whose responses a plugin retains.
+const maxCached = 256
+
+// Plugin is a loaded external rule executable.
+//
+// Plugin is safe for concurrent use by multiple goroutines.
+type Plugin struct {
+	name        string
+	path        string
+	args        []string
+	timeout     time.Duration
+	rules       []RuleInfo
+	fingerprint string
+
+	mu       sync.Mutex
+	requests map[*ir.Document]*request
+	results  map[string]*result
+}
+
+// request is the check request for one document, encoded once for all of the
+// plugin's rules.
+type request struct {
+	once sync.Once
+	body []byte
+	key  string
+	err  error
+}
+
+// result is the memoized outcome of a check request for one document content.
+//
+// done is closed once findings and failure are set.
+type result struct {
+	done     chan struct{}
+	findings map[string][]engine.Finding
+	failure  error
+}
+
+// Load resolves the plugin executable and asks it to describe its rules.
+//
+// Unlike check failures, a plugin that cannot be started or described is a
+// configuration error and is returned as such.
+func Load(ctx context.Context, cfg config.Plugin) (*Plugin, error) {
+	if cfg.Name == "" {
+		return nil, errors.New("plugin: missing name")
+	}
+	if cfg.Command == "" {
+		return nil, fmt.Errorf("plugin %s: missing command", cfg.Name)
+	}
+	path, err := exec.LookPath(cfg.Command)
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s: %w", cfg.Name, err)
+	}
+	bin, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s: %w", cfg.Name, err)
+	}
+	sum := sha256.Sum256(bin)
+	p := &Plugin{
+		name:        cfg.Name,
+		path:        path,
+		args:        cfg.Args,
+		timeout:     cfg.Timeout,
+		fingerprint: hex.EncodeToString(sum[:]),
+		requests:    map[*ir.Document]*request{},
+		results:     map[string]*result{},
+	}
+	if p.timeout <= 0 {
+		p.timeout = DefaultTimeout
+	}
+	req, _ := json.Marshal(Request{Version: ProtocolVersion, Method: MethodDescribe})
+	resp, err := p.call(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s: describe: %w", p.name, err)
+	}
+	if len(resp.Rules) == 0 {
+		return nil, fmt.Errorf("plugin %s: describe: no rules", p.name)
+	}
+	seen := map[string]struct{}{}
+	for _, r := range resp.Rules {
+		if r.ID == "" {
+			return nil, fmt.Errorf("plugin %s: describe: rule without id", p.name)
+		}
+		if _, dup := seen[r.ID]; dup {
+			return nil, fmt.Errorf("plugin %s: describe: duplicate rule %s", p.name, r.ID)
+		}
+		seen[r.ID] = struct{}{}
+	}
+	p.rules = resp.Rules
+	return p, nil
+}
+
+// Name returns the configured plugin name.
+func (p *Plugin) Name() string { return p.name }
+
+// Describe returns the rules the plugin implements.
+func (p *Plugin) Describe() []RuleInfo { return p.rules }
+
+// Rules returns one engine rule per described rule.
+//
+// None of the rules reports failures of the plugin until AssignFailureReporters
+// picks one among the rules that are selected to run.
+func (p *Plugin) Rules() []engine.Rule {
+	out := make([]engine.Rule, len(p.rules))
+	for i, r := range p.rules {
+		out[i] = &pluginRule{plugin: p, id: r.ID, info: r}
+	}
+	return out
+}
+
+// AssignFailureReporters makes the first rule of each plugin in rs report the
+// plugin's failures, so that they appear once per document whichever of the
+// plugin's rules run. It must be called with the rules about to be
+// registered, before any of them is checked; other rules are left alone.
+func AssignFailureReporters(rs []engine.Rule) {
+	seen := map[*Plugin]struct{}{}
+	for _, r := range rs {
+		pr, ok := r.(*pluginRule)
+		if !ok {
+			continue
+		}
+		_, dup := seen[pr.plugin]
+		pr.reportsFailure = !dup
+		seen[pr.plugin] = struct{}{}
+	}
+}
+
+// IsFailure reports whether f reports a plugin failure rather than a rule violation.
+func IsFailure(f engine.Finding) bool { return strings.HasPrefix(f.RuleID, FailurePrefix) }
+
+// check returns the findings of every plugin rule for d, invoking the plugin
+// at most once per distinct document content.
+//
+// The plugin runs detached from ctx, bounded by the plugin timeout, so that
+// the cancellation of one rule's context does not fail the sibling rules
+// sharing the result; a cancelled caller stops waiting and gets ctx.Err().
+func (p *Plugin) check(ctx context.Context, d *ir.Document) (*result, error) {
+	req, err := p.request(d)
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	res, ok := p.results[req.key]
+	if !ok {
+		if len(p.results) >= maxCached {
+			p.results = map[string]*result{}
+		}
+		res = &result{done: make(chan struct{})}
+		p.results[req.key] = res
+		go func() {
+			res.findings, res.failure = p.run(context.WithoutCancel(ctx), req.body)
+			close(res.done)
+		}()
+	}
+	p.mu.Unlock()
+	select {
+	case <-res.done:
+		return res, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// request returns the check request for d and its cache key, encoding the
+// document only for the first of the plugin's rules to check it.
+func (p *Plugin) request(d *ir.Document) (*request, error) {
+	p.mu.Lock()
+	req, ok := p.requests[d]
+	if !ok {
+		if len(p.requests) >= maxCached {
+			p.requests = map[*ir.Document]*request{}
+		}
+		req = &request{}
+		p.requests[d] = req
+	}
+	p.mu.Unlock()
+	req.once.Do(func() {
+		req.body, req.err = json.Marshal(Request{Version: ProtocolVersion, Method: MethodCheck, Document: Encode(d)})
+		sum := sha256.Sum256(req.body)
+		req.key = hex.EncodeToString(sum[:])
+	})
+	return req, req.err
+}
+
+// run sends a check request and groups the findings by rule.
+func (p *Plugin) run(ctx context.Context, req []byte) (map[string][]engine.Finding, error) {
+	resp, err := p.call(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	known := map[string]struct{}{}
+	for _, r := range p.rules {
+		known[r.ID] = struct{}{}
+	}
+	byRule := map[string][]engine.Finding{}
+	for _, f := range resp.Findings {
+		if _, ok := known[f.RuleID]; !ok {
+			return nil, fmt.Errorf("finding for undeclared rule %q", f.RuleID)
+		}
+		byRule[f.RuleID] = append(byRule[f.RuleID], f)
+	}
+	return byRule, nil
+}
+
+// call runs the plugin with req on standard input and decodes its response.
+func (p *Plugin) call(ctx context.Context, req []byte) (*Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, p.path, p.args...)
+	cmd.WaitDelay = time.Second
+	cmd.Stdin = bytes.NewReader(req)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("timed out after %s", p.timeout)
+		}
+		if msg := tail(stderr.Bytes()); msg != "" {
+			return nil, fmt.Errorf("%w: %s", err, msg)
+		}
+		return nil, err
+	}
+	var resp Response
+	dec := json.NewDecoder(&stdout)
+	if err := dec.Decode(&resp); err != nil {
+		return nil, fmt.Errorf("invalid response: %w", err)
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("invalid response: trailing data")
+	}
+	return &resp, nil
+}
+
+// tail returns the trimmed final maxStderr bytes of b.
+func tail(b []byte) string {
+	if len(b) > maxStderr {
+		b = b[len(b)-maxStderr:]
+	}
+	return strings.TrimSpace(string(b))
+}
+
+// pluginRule exposes one rule of a plugin to the engine.
+type pluginRule struct {
+	plugin         *Plugin
+	id             string
+	info           RuleInfo
+	reportsFailure bool
+}
+
+// ID returns the rule identifier declared by the plugin.
+func (r *pluginRule) ID() string { return r.id }
+
+// Metadata describes the rule as declared by the plugin; unknown categories are dropped.
+func (r *pluginRule) Metadata() engine.Metadata {
+	category, _ := engine.ParseCategory(r.info.Category)
+	return engine.Metadata{
+		Title:       r.info.Title,
+		Description: r.info.Description,
+		Category:    category,
+		Tags:        append([]string{"plugin", r.plugin.name}, r.info.Tags...),
+		Severity:    strings.ToLower(r.info.Severity),
+		Fixable:     r.info.Fixable,
+		OptIn:       r.info.OptIn,
+		DocsURL:     r.info.DocsURL,
+	}
+}
+
+// Fingerprint identifies the plugin executable and arguments, so that cached
+// findings are invalidated when the plugin changes.
+func (r *pluginRule) Fingerprint() string {
+	return r.plugin.fingerprint + "\x00" + strings.Join(r.plugin.args, "\x00")
+}
+
+// Check returns the plugin's findings for this rule.
+func (r *pluginRule) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	if d == nil || d.AST == nil {
+		return nil, nil
+	}
+	res, err := r.plugin.check(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	if res.failure != nil {
+		if !r.reportsFailure {
+			return nil, nil
+		}
+		return []engine.Finding{{
+			RuleID:   FailurePrefix + r.plugin.name,
+			Message:  fmt.Sprintf("plugin %s failed: %v", r.plugin.name, res.failure),
+			Severity: "error",
+		}}, nil
+	}
+	return res.findings[r.id], nil
+}
@@ -0,0 +1,244 @@
+// file: internal/plugin/plugin_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/config"
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// helperEnv selects the behavior of the test binary when it runs as a plugin.
+const helperEnv = "DOCKER_LINT_TEST_PLUGIN"
+
+// TestHelperPlugin is not a real test: it is the plugin executable used by the
+// tests below, selected by helperEnv. It appends each request's method to the
+// file named by DOCKER_LINT_TEST_PLUGIN_LOG.
+func TestHelperPlugin(t *testing.T) {
+	mode := os.Getenv(helperEnv)
+	if mode == "" {
+		return
+	}
+	var req Request
+	if err := json.NewDecoder(os.Stdin).Decode(&req); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(3)
+	}
+	if log := os.Getenv(helperEnv + "_LOG"); log != "" {
+		f, _ := os.OpenFile(log, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		fmt.Fprintln(f, req.Method)
+		f.Close()
+	}
+	var resp Response
+	switch {
+	case req.Method == MethodDescribe:
+		resp.Rules = []RuleInfo{{ID: "ACME100", Title: "Curl is forbidden", Description: "no curl", Category: "security", Severity: "Error"}, {ID: "ACME101", Category: "bogus", OptIn: true}}
+	case mode == "crash":
+		fmt.Fprintln(os.Stderr, "boom")
+		os.Exit(2)
+	case mode == "hang":
+		time.Sleep(10 * time.Second)
+	case mode == "slow":
+		time.Sleep(time.Second)
+	case mode == "undeclared":
+		resp.Findings = []engine.Finding{{RuleID: "OTHER", Message: "x"}}
+	default:
+		for _, st := range req.Document.Stages {
+			for _, in := range st.Instructions {
+				for _, c := range in.Commands {
+					if c.Argv[0] == "curl" {
+						resp.Findings = append(resp.Findings, engine.Finding{RuleID: "ACME100", Message: "curl in " + st.Image, Line: c.Line})
+					}
+				}
+			}
+		}
+	}
+	_ = json.NewEncoder(os.Stdout).Encode(resp)
+	os.Exit(0)
+}
+
+// loadHelper loads the test binary as a plugin running in mode.
+func loadHelper(t *testing.T, mode string, timeout time.Duration) *Plugin {
+	t.Helper()
+	t.Setenv(helperEnv, mode)
+	p, err := Load(context.Background(), config.Plugin{Name: "acme", Command: os.Args[0], Args: []string{"-test.run=^TestHelperPlugin$"}, Timeout: timeout})
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	return p
+}
+
+// buildDoc parses src into a document.
+func buildDoc(t *testing.T, src string) *ir.Document {
+	t.Helper()
+	res, err := parser.Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	doc, err := ir.BuildDocument("Dockerfile", res.AST)
+	if err != nil {
+		t.Fatalf("build doc: %v", err)
+	}
+	return doc
+}
+
+// checkAll runs every rule of p against d, the first reporting plugin failures.
+func checkAll(t *testing.T, p *Plugin, d *ir.Document) []engine.Finding {
+	t.Helper()
+	return checkRules(t, p.Rules(), d)
+}
+
+// checkRules assigns failure reporting among rs and runs them against d.
+func checkRules(t *testing.T, rs []engine.Rule, d *ir.Document) []engine.Finding {
+	t.Helper()
+	AssignFailureReporters(rs)
+	var all []engine.Finding
+	for _, r := range rs {
+		fnds, err := r.Check(context.Background(), d)
+		if err != nil {
+			t.Fatalf("check %s: %v", r.ID(), err)
+		}
+		all = append(all, fnds...)
+	}
+	return all
+}
+
+// TestIntegrationPluginCheck verifies describe, findings and per-document caching.
+func TestIntegrationPluginCheck(t *testing.T) {
+	log := t.TempDir() + "/calls"
+	t.Setenv(helperEnv+"_LOG", log)
+	p := loadHelper(t, "ok", 0)
+	var ids []string
+	for _, r := range p.Rules() {
+		ids = append(ids, r.ID())
+	}
+	if strings.Join(ids, ",") != "ACME100,ACME101" {
+		t.Fatalf("unexpected rules %v", ids)
+	}
+	m := engine.Describe(p.Rules()[0])
+	if m.Title != "Curl is forbidden" || m.Category != engine.CategorySecurity || m.Severity != "error" || strings.Join(m.Tags, ",") != "plugin,acme" {
+		t.Fatalf("unexpected metadata %+v", m)
+	}
+	if m := engine.Describe(p.Rules()[1]); m.Title != "ACME101" || m.Category != "" || !m.OptIn {
+		t.Fatalf("unexpected metadata %+v", m)
+	}
+	doc := buildDoc(t, "ARG V=3.20\nFROM alpine:$V\nRUN <<EOF\necho hi\ncurl -fsSL https://example.com\nEOF\n")
+	fnds := checkAll(t, p, doc)
+	if len(fnds) != 1 || fnds[0].RuleID != "ACME100" || fnds[0].Line != 5 || fnds[0].Message != "curl in alpine:3.20" {
+		t.Fatalf("unexpected findings %+v", fnds)
+	}
+	checkAll(t, p, buildDoc(t, "ARG V=3.20\nFROM alpine:$V\nRUN <<EOF\necho hi\ncurl -fsSL https://example.com\nEOF\n"))
+	b, err := os.ReadFile(log)
+	if err != nil {
+		t.Fatalf("read log: %v", err)
+	}
+	if got := strings.Fields(string(b)); strings.Join(got, ",") != "describe,check" {
+		t.Fatalf("expected one describe and one cached check, got %v", got)
+	}
+	if req, _ := p.request(doc); len(p.requests) != 2 || req != p.requests[doc] {
+		t.Fatalf("expected one encoded request per document, got %d", len(p.requests))
+	}
+}
+
+// TestIntegrationPluginSiblingCancel verifies that a rule whose context ends
+// while the plugin runs does not turn the result it shares with a sibling
+// rule into a failure.
+func TestIntegrationPluginSiblingCancel(t *testing.T) {
+	p := loadHelper(t, "slow", 0)
+	rs := p.Rules()
+	AssignFailureReporters([]engine.Rule{rs[1], rs[0]})
+	doc := buildDoc(t, "FROM alpine:3.20\n")
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	errc := make(chan error, 1)
+	go func() {
+		_, err := rs[0].Check(ctx, doc)
+		errc <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	fnds, err := rs[1].Check(context.Background(), doc)
+	if err != nil || len(fnds) != 0 {
+		t.Fatalf("expected no findings for the sibling rule, got %+v, %v", fnds, err)
+	}
+	if err := <-errc; err == nil {
+		t.Fatalf("expected the cancelled rule to fail")
+	}
+}
+
+// TestIntegrationPluginFailures verifies that plugin failures become a single finding.
+func TestIntegrationPluginFailures(t *testing.T) {
+	doc := buildDoc(t, "FROM alpine:3.20\n")
+	cases := map[string]string{
+		"crash":      "boom",
+		"hang":       "timed out",
+		"undeclared": "undeclared rule",
+	}
+	for mode, want := range cases {
+		// Loading re-executes the test binary, which is slow under -race, so
+		// only the hanging check gets a short timeout.
+		p := loadHelper(t, mode, 0)
+		if mode == "hang" {
+			p.timeout = 500 * time.Millisecond
+		}
+		fnds := checkAll(t, p, doc)
+		if len(fnds) != 1 || fnds[0].RuleID != "plugin/acme" || !IsFailure(fnds[0]) || !strings.Contains(fnds[0].Message, want) {
+			t.Fatalf("%s: unexpected findings %+v", mode, fnds)
+		}
+	}
+}
+
+// TestIntegrationPluginFailureSelection verifies that failures are reported
+// once by whichever of the plugin's rules is selected.
+func TestIntegrationPluginFailureSelection(t *testing.T) {
+	p := loadHelper(t, "crash", 0)
+	doc := buildDoc(t, "FROM alpine:3.20\n")
+	for _, rs := range [][]engine.Rule{p.Rules()[1:], append(p.Rules(), p.Rules()...)} {
+		if fnds := checkRules(t, rs, doc); len(fnds) != 1 || !IsFailure(fnds[0]) {
+			t.Fatalf("expected one failure finding, got %+v", fnds)
+		}
+	}
+}
+
+// TestLoadErrors verifies validation of plugin configuration.
+func TestLoadErrors(t *testing.T) {
+	cases := map[string]config.Plugin{
+		"missing name":    {Command: "true"},
+		"missing command": {Name: "x"},
+		"not found":       {Name: "x", Command: "docker-lint-no-such-plugin"},
+	}
+	for want, cfg := range cases {
+		if _, err := Load(context.Background(), cfg); err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("expected %q error, got %v", want, err)
+		}
+	}
+}
+
+// TestEncode verifies the serialized document shape.
+func TestEncode(t *testing.T) {
+	doc := buildDoc(t, "FROM golang:1.22 AS build\nENV CGO_ENABLED=0\nRUN go build\nFROM build\nCOPY --from=build /a /b\n")
+	enc := Encode(doc)
+	if enc.Target != 1 || len(enc.Stages) != 2 {
+		t.Fatalf("unexpected document %+v", enc)
+	}
+	build := enc.Stages[0]
+	if build.Name != "build" || build.Vars["CGO_ENABLED"] != "0" || len(build.Instructions) != 3 {
+		t.Fatalf("unexpected stage %+v", build)
+	}
+	run := build.Instructions[2]
+	if run.Keyword != "RUN" || run.Range != (Range{Start: 3, End: 3}) || len(run.Commands) != 1 || run.Commands[0].Argv[1] != "build" {
+		t.Fatalf("unexpected instruction %+v", run)
+	}
+	if final := enc.Stages[1]; final.Parent != 0 || final.Vars["CGO_ENABLED"] != "0" || final.Instructions[1].Flags[0] != "--from=build" {
+		t.Fatalf("unexpected final stage %+v", final)
+	}
+}
@@ -0,0 +1,162 @@
+// file: internal/plugin/protocol.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package plugin
+
+import (
+	"strings"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// ProtocolVersion is the version of the plugin protocol sent with every request.
+const ProtocolVersion = 1
+
+// Methods a plugin must answer.
+const (
+	MethodDescribe = "describe"
+	MethodCheck    = "check"
+)
+
+// Request is the JSON object written to a plugin's standard input.
+type Request struct {
+	Version  int       `json:"version"`
+	Method   string    `json:"method"`
+	Document *Document `json:"document,omitempty"`
+}
+
+// Response is the JSON object a plugin writes to standard output.
+//
+// Describe requests are answered with Rules and check requests with Findings.
+type Response struct {
+	Rules    []RuleInfo       `json:"rules,omitempty"`
+	Findings []engine.Finding `json:"findings,omitempty"`
+}
+
+// RuleInfo describes a rule implemented by a plugin.
+//
+// Every field but ID is optional and feeds the rule's metadata.
+type RuleInfo struct {
+	ID          string   `json:"id"`
+	Title       string   `json:"title,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Category    string   `json:"category,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	Severity    string   `json:"severity,omitempty"`
+	Fixable     bool     `json:"fixable,omitempty"`
+	OptIn       bool     `json:"optIn,omitempty"`
+	DocsURL     string   `json:"docsUrl,omitempty"`
+}
+
+// Document is the JSON serialization of an ir.Document.
+//
+// Args holds the ARGs declared before the first FROM. Target is the index of
+// the stage being built, or -1 when the document has no stages.
+type Document struct {
+	Path   string            `json:"path"`
+	Target int               `json:"target"`
+	Args   map[string]string `json:"args"`
+	Stages []Stage           `json:"stages"`
+}
+
+// Stage is the serialization of an ir.Stage.
+//
+// From is the image reference as written and Image the reference with build
+// variables substituted. Instructions starts with the FROM instruction. Vars
+// holds the ARG and ENV values in scope at the end of the stage.
+type Stage struct {
+	Index        int               `json:"index"`
+	Name         string            `json:"name,omitempty"`
+	From         string            `json:"from"`
+	Image        string            `json:"image"`
+	Parent       int               `json:"parent"`
+	Deps         []int             `json:"deps,omitempty"`
+	Reachable    bool              `json:"reachable"`
+	Vars         map[string]string `json:"vars"`
+	Instructions []Instruction     `json:"instructions"`
+}
+
+// Instruction is the serialization of a single Dockerfile instruction.
+//
+// Keyword is upper case. Args are the parsed arguments without flags, and
+// Commands lists the commands run by a RUN instruction.
+type Instruction struct {
+	Keyword  string    `json:"keyword"`
+	Args     []string  `json:"args"`
+	Flags    []string  `json:"flags,omitempty"`
+	JSON     bool      `json:"json,omitempty"`
+	Original string    `json:"original"`
+	Range    Range     `json:"range"`
+	Heredocs []Heredoc `json:"heredocs,omitempty"`
+	Commands []Command `json:"commands,omitempty"`
+}
+
+// Range is the inclusive span of lines an instruction occupies, heredocs included.
+type Range struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// Heredoc is the serialization of an ir.Heredoc.
+type Heredoc struct {
+	Name        string `json:"name"`
+	Content     string `json:"content"`
+	Line        int    `json:"line"`
+	Interpreter string `json:"interpreter,omitempty"`
+}
+
+// Command is the serialization of an ir.Command.
+type Command struct {
+	Argv []string `json:"argv"`
+	Line int      `json:"line"`
+}
+
+// Encode serializes d for transmission to a plugin.
+func Encode(d *ir.Document) *Document {
+	out := &Document{Path: d.Filepath, Target: d.Target, Args: d.Vars(nil), Stages: []Stage{}}
+	for _, st := range d.Stages {
+		image, _ := d.Expand(st.Node, st.From)
+		s := Stage{
+			Index:        st.Index,
+			Name:         st.Name,
+			From:         st.From,
+			Image:        image,
+			Parent:       st.Parent,
+			Deps:         st.Deps,
+			Reachable:    st.Reachable,
+			Vars:         d.StageVars(st),
+			Instructions: []Instruction{encodeInstruction(d, st.Node)},
+		}
+		for _, n := range st.Instructions {
+			s.Instructions = append(s.Instructions, encodeInstruction(d, n))
+		}
+		out.Stages = append(out.Stages, s)
+	}
+	return out
+}
+
+// encodeInstruction serializes a single instruction node.
+func encodeInstruction(d *ir.Document, n *parser.Node) Instruction {
+	in := Instruction{
+		Keyword:  strings.ToUpper(n.Value),
+		Args:     []string{},
+		Flags:    n.Flags,
+		JSON:     n.Attributes["json"],
+		Original: n.Original,
+		Range:    Range{Start: n.StartLine, End: max(n.EndLine, n.StartLine)},
+	}
+	for tok := n.Next; tok != nil; tok = tok.Next {
+		in.Args = append(in.Args, tok.Value)
+	}
+	for _, h := range ir.Heredocs(n) {
+		in.Heredocs = append(in.Heredocs, Heredoc{Name: h.Name, Content: h.Content, Line: h.Line, Interpreter: h.Interpreter})
+	}
+	if strings.EqualFold(n.Value, "run") {
+		for _, c := range d.RunCommands(n) {
+			in.Commands = append(in.Commands, Command{Argv: c.Argv, Line: c.Line})
+		}
+	}
+	return in
+}
@@ -0,0 +1,403 @@
+// file: internal/policy/eval.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package policy
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Value is a policy value: nil, bool, int64, string, []Value,
+// map[string]Value or Func.
+type Value = any
+
+// Func is a function-valued field, called with method syntax such as stage.has("USER").
+type Func func(args []Value) (Value, error)
+
+// maxSteps bounds the number of nodes evaluated by a single evaluation.
+const maxSteps = 100000
+
+// regexCache memoizes compiled expressions used with matches.
+var regexCache sync.Map
+
+// evaluator evaluates one expression against a set of variables.
+type evaluator struct {
+	vars  map[string]Value
+	steps int
+}
+
+func (e *evaluator) eval(n node) (Value, error) {
+	if e.steps++; e.steps > maxSteps {
+		return nil, fmt.Errorf("evaluation exceeded %d steps", maxSteps)
+	}
+	switch n := n.(type) {
+	case *literalNode:
+		return n.value, nil
+	case *identNode:
+		v, ok := e.vars[n.name]
+		if !ok {
+			return nil, fmt.Errorf("undefined variable %q", n.name)
+		}
+		return v, nil
+	case *listNode:
+		out := make([]Value, 0, len(n.elems))
+		for _, el := range n.elems {
+			v, err := e.eval(el)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, v)
+		}
+		return out, nil
+	case *unaryNode:
+		v, err := e.eval(n.x)
+		if err != nil {
+			return nil, err
+		}
+		if n.op == "!" {
+			b, ok := v.(bool)
+			if !ok {
+				return nil, fmt.Errorf("operator ! needs bool, got %s", typeName(v))
+			}
+			return !b, nil
+		}
+		i, ok := v.(int64)
+		if !ok {
+			return nil, fmt.Errorf("operator - needs int, got %s", typeName(v))
+		}
+		return -i, nil
+	case *binaryNode:
+		return e.binary(n)
+	case *fieldNode:
+		v, err := e.eval(n.x)
+		if err != nil {
+			return nil, err
+		}
+		m, ok := v.(map[string]Value)
+		if !ok {
+			return nil, fmt.Errorf("cannot select field %q of %s", n.name, typeName(v))
+		}
+		f, ok := m[n.name]
+		if !ok {
+			return nil, fmt.Errorf("no field %q", n.name)
+		}
+		return f, nil
+	case *indexNode:
+		return e.index(n)
+	case *callNode:
+		return e.call(n)
+	case *macroNode:
+		return e.macro(n)
+	}
+	return nil, fmt.Errorf("unknown expression %T", n)
+}
+
+// binary evaluates a binary operator; && and || short-circuit.
+func (e *evaluator) binary(n *binaryNode) (Value, error) {
+	x, err := e.eval(n.x)
+	if err != nil {
+		return nil, err
+	}
+	if n.op == "&&" || n.op == "||" {
+		b, ok := x.(bool)
+		if !ok {
+			return nil, fmt.Errorf("operator %s needs bool, got %s", n.op, typeName(x))
+		}
+		if b == (n.op == "||") {
+			return b, nil
+		}
+		y, err := e.eval(n.y)
+		if err != nil {
+			return nil, err
+		}
+		if b, ok = y.(bool); !ok {
+			return nil, fmt.Errorf("operator %s needs bool, got %s", n.op, typeName(y))
+		}
+		return b, nil
+	}
+	y, err := e.eval(n.y)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "==":
+		return equal(x, y), nil
+	case "!=":
+		return !equal(x, y), nil
+	case "in":
+		return contains(y, x)
+	}
+	switch a := x.(type) {
+	case int64:
+		if b, ok := y.(int64); ok {
+			return compare(n.op, a, b), nil
+		}
+	case string:
+		if b, ok := y.(string); ok {
+			return compare(n.op, a, b), nil
+		}
+	}
+	return nil, fmt.Errorf("operator %s cannot compare %s and %s", n.op, typeName(x), typeName(y))
+}
+
+// compare applies an ordering operator.
+func compare[T int64 | string](op string, a, b T) bool {
+	switch op {
+	case "<":
+		return a < b
+	case "<=":
+		return a <= b
+	case ">":
+		return a > b
+	}
+	return a >= b
+}
+
+// index evaluates list[int] and map[string]; missing map keys yield null.
+func (e *evaluator) index(n *indexNode) (Value, error) {
+	x, err := e.eval(n.x)
+	if err != nil {
+		return nil, err
+	}
+	i, err := e.eval(n.index)
+	if err != nil {
+		return nil, err
+	}
+	switch c := x.(type) {
+	case []Value:
+		k, ok := i.(int64)
+		if !ok {
+			return nil, fmt.Errorf("list index must be int, got %s", typeName(i))
+		}
+		if k < 0 {
+			k += int64(len(c))
+		}
+		if k < 0 || k >= int64(len(c)) {
+			return nil, fmt.Errorf("list index %d out of range", k)
+		}
+		return c[k], nil
+	case map[string]Value:
+		k, ok := i.(string)
+		if !ok {
+			return nil, fmt.Errorf("map key must be string, got %s", typeName(i))
+		}
+		return c[k], nil
+	}
+	return nil, fmt.Errorf("cannot index %s", typeName(x))
+}
+
+// call evaluates global functions, function-valued fields and built-in methods.
+func (e *evaluator) call(n *callNode) (Value, error) {
+	args := make([]Value, 0, len(n.args))
+	for _, a := range n.args {
+		v, err := e.eval(a)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, v)
+	}
+	if n.recv == nil {
+		if n.name == "len" && len(args) == 1 {
+			return length(args[0])
+		}
+		return nil, fmt.Errorf("unknown function %s/%d", n.name, len(args))
+	}
+	recv, err := e.eval(n.recv)
+	if err != nil {
+		return nil, err
+	}
+	if m, ok := recv.(map[string]Value); ok {
+		if f, ok := m[n.name].(Func); ok {
+			return f(args)
+		}
+	}
+	return method(recv, n.name, args)
+}
+
+// macro evaluates exists, all and filter over a list.
+func (e *evaluator) macro(n *macroNode) (Value, error) {
+	recv, err := e.eval(n.recv)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := recv.([]Value)
+	if !ok {
+		return nil, fmt.Errorf("%s needs a list, got %s", n.kind, typeName(recv))
+	}
+	saved, shadowed := e.vars[n.name]
+	defer func() {
+		if shadowed {
+			e.vars[n.name] = saved
+		} else {
+			delete(e.vars, n.name)
+		}
+	}()
+	var kept []Value
+	for _, el := range list {
+		e.vars[n.name] = el
+		v, err := e.eval(n.body)
+		if err != nil {
+			return nil, err
+		}
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%s predicate must be bool, got %s", n.kind, typeName(v))
+		}
+		switch {
+		case n.kind == "exists" && b:
+			return true, nil
+		case n.kind == "all" && !b:
+			return false, nil
+		case n.kind == "filter" && b:
+			kept = append(kept, el)
+		}
+	}
+	switch n.kind {
+	case "exists":
+		return false, nil
+	case "all":
+		return true, nil
+	}
+	if kept == nil {
+		kept = []Value{}
+	}
+	return kept, nil
+}
+
+// method evaluates a built-in string or list method.
+func method(recv Value, name string, args []Value) (Value, error) {
+	switch r := recv.(type) {
+	case string:
+		switch name {
+		case "lower":
+			if len(args) == 0 {
+				return strings.ToLower(r), nil
+			}
+		case "upper":
+			if len(args) == 0 {
+				return strings.ToUpper(r), nil
+			}
+		case "startsWith", "endsWith", "contains", "matches", "split":
+			if len(args) != 1 {
+				break
+			}
+			s, ok := args[0].(string)
+			if !ok {
+				return nil, fmt.Errorf("%s needs a string argument, got %s", name, typeName(args[0]))
+			}
+			switch name {
+			case "startsWith":
+				return strings.HasPrefix(r, s), nil
+			case "endsWith":
+				return strings.HasSuffix(r, s), nil
+			case "contains":
+				return strings.Contains(r, s), nil
+			case "split":
+				parts := strings.Split(r, s)
+				out := make([]Value, len(parts))
+				for i, p := range parts {
+					out[i] = p
+				}
+				return out, nil
+			}
+			re, err := compileRegex(s)
+			if err != nil {
+				return nil, err
+			}
+			return re.MatchString(r), nil
+		}
+	case []Value:
+		if name == "contains" && len(args) == 1 {
+			return contains(r, args[0])
+		}
+	}
+	return nil, fmt.Errorf("%s has no method %s/%d", typeName(recv), name, len(args))
+}
+
+// compileRegex compiles expr once per process.
+func compileRegex(expr string) (*regexp.Regexp, error) {
+	if re, ok := regexCache.Load(expr); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("matches: %w", err)
+	}
+	regexCache.Store(expr, re)
+	return re, nil
+}
+
+// contains implements the in operator: list membership, map keys and substrings.
+func contains(container, x Value) (Value, error) {
+	switch c := container.(type) {
+	case []Value:
+		for _, el := range c {
+			if equal(el, x) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case map[string]Value:
+		k, ok := x.(string)
+		if !ok {
+			return nil, fmt.Errorf("map key must be string, got %s", typeName(x))
+		}
+		_, ok = c[k]
+		return ok, nil
+	case string:
+		s, ok := x.(string)
+		if !ok {
+			return nil, fmt.Errorf("cannot search string for %s", typeName(x))
+		}
+		return strings.Contains(c, s), nil
+	}
+	return nil, fmt.Errorf("operator in needs a list, map or string, got %s", typeName(container))
+}
+
+// length returns the length of a string, list or map.
+func length(v Value) (Value, error) {
+	switch c := v.(type) {
+	case string:
+		return int64(len(c)), nil
+	case []Value:
+		return int64(len(c)), nil
+	case map[string]Value:
+		return int64(len(c)), nil
+	}
+	return nil, fmt.Errorf("len needs a string, list or map, got %s", typeName(v))
+}
+
+// equal reports deep equality; functions are never equal.
+func equal(x, y Value) bool {
+	if _, ok := x.(Func); ok {
+		return false
+	}
+	if _, ok := y.(Func); ok {
+		return false
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+// typeName names the policy type of v for error messages.
+func typeName(v Value) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "bool"
+	case int64:
+		return "int"
+	case string:
+		return "string"
+	case []Value:
+		return "list"
+	case map[string]Value:
+		return "map"
+	case Func:
+		return "function"
+	}
+	return fmt.Sprintf("%T", v)
+}
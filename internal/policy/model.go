@@ -0,0 +1,162 @@
+// file: internal/policy/model.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package policy
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// Document returns the expression model of d.
+//
+// The model has the fields path, target, args (the ARGs declared before the
+// first FROM) and stages, a list of Stage values.
+func Document(d *ir.Document) map[string]Value {
+	stages := make([]Value, 0, len(d.Stages))
+	for _, st := range d.Stages {
+		stages = append(stages, Stage(d, st))
+	}
+	return map[string]Value{
+		"path":   d.Filepath,
+		"target": int64(d.Target),
+		"args":   stringMap(d.Vars(nil)),
+		"stages": stages,
+	}
+}
+
+// Stage returns the expression model of st.
+//
+// The model has the fields index, name, isFinal (st is the build target),
+// reachable, parent (the index of the stage named by FROM, or -1), from (see
+// Image), vars (ARG and ENV values at the end of the stage), labels and user
+// (including values inherited through FROM <stage>), instructions (a list of
+// Instruction values, FROM excluded) and the function has(keyword), which
+// reports whether the stage or a stage it inherits from contains such an
+// instruction.
+func Stage(d *ir.Document, st *ir.Stage) map[string]Value {
+	lineage := []*ir.Stage{st}
+	for cur := st; cur.Parent >= 0 && cur.Parent < cur.Index; {
+		cur = d.Stages[cur.Parent]
+		lineage = append(lineage, cur)
+	}
+	labels := map[string]Value{}
+	user := ""
+	keywords := map[string]struct{}{}
+	for i := len(lineage) - 1; i >= 0; i-- {
+		for _, n := range lineage[i].Instructions {
+			keywords[strings.ToUpper(n.Value)] = struct{}{}
+			switch strings.ToLower(n.Value) {
+			case "label":
+				for _, p := range ir.LabelPairs(n) {
+					labels[p.Key] = p.Value
+				}
+			case "user":
+				if n.Next != nil {
+					user, _ = d.Expand(n, n.Next.Value)
+				}
+			}
+		}
+	}
+	instructions := make([]Value, 0, len(st.Instructions))
+	for _, n := range st.Instructions {
+		instructions = append(instructions, Instruction(d, n))
+	}
+	return map[string]Value{
+		"index":        int64(st.Index),
+		"name":         st.Name,
+		"isFinal":      st.Index == d.Target,
+		"reachable":    st.Reachable,
+		"parent":       int64(st.Parent),
+		"from":         Image(d, st),
+		"vars":         stringMap(d.StageVars(st)),
+		"labels":       labels,
+		"user":         user,
+		"instructions": instructions,
+		"has": Func(func(args []Value) (Value, error) {
+			if len(args) != 1 {
+				return nil, fmt.Errorf("has needs one argument, got %d", len(args))
+			}
+			kw, ok := args[0].(string)
+			if !ok {
+				return nil, fmt.Errorf("has needs a string argument, got %s", typeName(args[0]))
+			}
+			_, found := keywords[strings.ToUpper(kw)]
+			return found, nil
+		}),
+	}
+}
+
+// Image returns the expression model of the base image of st.
+//
+// The model has the fields raw (as written), image (with build variables
+// substituted), registry, repository, tag, digest and isStage, which reports
+// whether FROM names an earlier stage.
+func Image(d *ir.Document, st *ir.Stage) map[string]Value {
+	image, _ := d.Expand(st.Node, st.From)
+	ref := ir.ParseImageRef(image)
+	isStage := st.Parent >= 0
+	if isStage {
+		ref = ir.ImageRef{Repository: image}
+	}
+	return map[string]Value{
+		"raw":        st.From,
+		"image":      image,
+		"registry":   ref.Registry,
+		"repository": ref.Repository,
+		"tag":        ref.Tag,
+		"digest":     ref.Digest,
+		"isStage":    isStage,
+	}
+}
+
+// Instruction returns the expression model of n.
+//
+// The model has the fields keyword (upper case), args, flags, line, endLine,
+// original and, for RUN, commands: a list of values with the fields name (the
+// base name of the executable), args and line.
+func Instruction(d *ir.Document, n *parser.Node) map[string]Value {
+	args := []Value{}
+	for tok := n.Next; tok != nil; tok = tok.Next {
+		args = append(args, tok.Value)
+	}
+	flags := make([]Value, 0, len(n.Flags))
+	for _, f := range n.Flags {
+		flags = append(flags, f)
+	}
+	commands := []Value{}
+	if strings.EqualFold(n.Value, "run") {
+		for _, c := range d.RunCommands(n) {
+			if len(c.Argv) == 0 {
+				continue
+			}
+			argv := make([]Value, 0, len(c.Argv)-1)
+			for _, a := range c.Argv[1:] {
+				argv = append(argv, a)
+			}
+			commands = append(commands, map[string]Value{"name": path.Base(c.Argv[0]), "args": argv, "line": int64(c.Line)})
+		}
+	}
+	return map[string]Value{
+		"keyword":  strings.ToUpper(n.Value),
+		"args":     args,
+		"flags":    flags,
+		"line":     int64(n.StartLine),
+		"endLine":  int64(max(n.EndLine, n.StartLine)),
+		"original": n.Original,
+		"commands": commands,
+	}
+}
+
+// stringMap converts a string map to a model map.
+func stringMap(m map[string]string) map[string]Value {
+	out := make(map[string]Value, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,406 @@
+// file: internal/policy/parse.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package policy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// maxSource bounds the length of an expression.
+const maxSource = 4096
+
+// maxDepth bounds the nesting depth of an expression.
+const maxDepth = 64
+
+// tokenKind classifies lexical tokens.
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokInt
+	tokString
+	tokOp
+)
+
+// token is a lexical token with its byte offset in the source.
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// operators lists the operator tokens, longest first.
+var operators = []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "!", "-", "(", ")", "[", "]", ",", "."}
+
+// lex splits src into tokens.
+func lex(src string) ([]token, error) {
+	var toks []token
+	for i := 0; i < len(src); {
+		c := rune(src[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '_' || unicode.IsLetter(c):
+			j := i
+			for j < len(src) && (src[j] == '_' || unicode.IsLetter(rune(src[j])) || unicode.IsDigit(rune(src[j]))) {
+				j++
+			}
+			toks = append(toks, token{tokIdent, src[i:j], i})
+			i = j
+		case unicode.IsDigit(c):
+			j := i
+			for j < len(src) && unicode.IsDigit(rune(src[j])) {
+				j++
+			}
+			toks = append(toks, token{tokInt, src[i:j], i})
+			i = j
+		case c == '"' || c == '\'':
+			j := i + 1
+			for j < len(src) && src[j] != src[i] {
+				if src[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(src) {
+				return nil, fmt.Errorf("unterminated string at offset %d", i)
+			}
+			s, err := unquote(src[i : j+1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid string at offset %d: %w", i, err)
+			}
+			toks = append(toks, token{tokString, s, i})
+			i = j + 1
+		default:
+			op := ""
+			for _, o := range operators {
+				if strings.HasPrefix(src[i:], o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected %q at offset %d", c, i)
+			}
+			toks = append(toks, token{tokOp, op, i})
+			i += len(op)
+		}
+	}
+	return append(toks, token{tokEOF, "", len(src)}), nil
+}
+
+// unquote decodes a single- or double-quoted string literal with Go escapes.
+func unquote(lit string) (string, error) {
+	if lit[0] == '"' {
+		return strconv.Unquote(lit)
+	}
+	var b strings.Builder
+	body := lit[1 : len(lit)-1]
+	for i := 0; i < len(body); i++ {
+		switch {
+		case body[i] == '\\' && i+1 < len(body) && body[i+1] == '\'':
+			b.WriteByte('\'')
+			i++
+		case body[i] == '\\' && i+1 < len(body):
+			b.WriteString(body[i : i+2])
+			i++
+		case body[i] == '"':
+			b.WriteString(`\"`)
+		default:
+			b.WriteByte(body[i])
+		}
+	}
+	return strconv.Unquote(`"` + b.String() + `"`)
+}
+
+// node is an expression tree node.
+type node interface{}
+
+type (
+	// literalNode is a constant value.
+	literalNode struct{ value Value }
+	// identNode references a variable.
+	identNode struct{ name string }
+	// listNode builds a list from its elements.
+	listNode struct{ elems []node }
+	// unaryNode applies ! or - to x.
+	unaryNode struct {
+		op string
+		x  node
+	}
+	// binaryNode applies a binary operator.
+	binaryNode struct {
+		op   string
+		x, y node
+	}
+	// fieldNode selects a field of a map.
+	fieldNode struct {
+		x    node
+		name string
+	}
+	// indexNode indexes a list or map.
+	indexNode struct{ x, index node }
+	// callNode calls a global function (recv nil) or a method of recv.
+	callNode struct {
+		recv node
+		name string
+		args []node
+	}
+	// macroNode evaluates body for each element of list bound to name.
+	macroNode struct {
+		recv node
+		kind string
+		name string
+		body node
+	}
+)
+
+// macros lists the list methods whose first argument binds a variable.
+var macros = map[string]struct{}{"exists": {}, "all": {}, "filter": {}}
+
+// exprParser is a recursive-descent parser over a token slice.
+type exprParser struct {
+	toks  []token
+	pos   int
+	depth int
+}
+
+// parse parses src into an expression tree.
+func parse(src string) (node, error) {
+	if len(src) > maxSource {
+		return nil, fmt.Errorf("expression longer than %d bytes", maxSource)
+	}
+	toks, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &exprParser{toks: toks}
+	n, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, fmt.Errorf("unexpected %q at offset %d", t.text, t.pos)
+	}
+	return n, nil
+}
+
+func (p *exprParser) peek() token { return p.toks[p.pos] }
+
+func (p *exprParser) next() token {
+	t := p.toks[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+// accept consumes the next token when it is the operator or keyword text.
+func (p *exprParser) accept(text string) bool {
+	if t := p.peek(); (t.kind == tokOp || t.kind == tokIdent) && t.text == text {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// expect consumes the operator text or fails.
+func (p *exprParser) expect(text string) error {
+	if !p.accept(text) {
+		t := p.peek()
+		if t.kind == tokEOF {
+			return fmt.Errorf("expected %q at end of expression", text)
+		}
+		return fmt.Errorf("expected %q at offset %d, found %q", text, t.pos, t.text)
+	}
+	return nil
+}
+
+func (p *exprParser) expr() (node, error) {
+	if p.depth++; p.depth > maxDepth {
+		return nil, fmt.Errorf("expression nested deeper than %d", maxDepth)
+	}
+	defer func() { p.depth-- }()
+	return p.or()
+}
+
+func (p *exprParser) or() (node, error) {
+	x, err := p.and()
+	for err == nil && p.accept("||") {
+		var y node
+		if y, err = p.and(); err == nil {
+			x = &binaryNode{op: "||", x: x, y: y}
+		}
+	}
+	return x, err
+}
+
+func (p *exprParser) and() (node, error) {
+	x, err := p.comparison()
+	for err == nil && p.accept("&&") {
+		var y node
+		if y, err = p.comparison(); err == nil {
+			x = &binaryNode{op: "&&", x: x, y: y}
+		}
+	}
+	return x, err
+}
+
+func (p *exprParser) comparison() (node, error) {
+	x, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">", "in"} {
+		if p.accept(op) {
+			y, err := p.unary()
+			if err != nil {
+				return nil, err
+			}
+			return &binaryNode{op: op, x: x, y: y}, nil
+		}
+	}
+	return x, nil
+}
+
+func (p *exprParser) unary() (node, error) {
+	for _, op := range []string{"!", "-"} {
+		if p.accept(op) {
+			if p.depth++; p.depth > maxDepth {
+				return nil, fmt.Errorf("expression nested deeper than %d", maxDepth)
+			}
+			defer func() { p.depth-- }()
+			x, err := p.unary()
+			if err != nil {
+				return nil, err
+			}
+			return &unaryNode{op: op, x: x}, nil
+		}
+	}
+	return p.postfix()
+}
+
+func (p *exprParser) postfix() (node, error) {
+	x, err := p.primary()
+	for err == nil {
+		switch {
+		case p.accept("."):
+			t := p.next()
+			if t.kind != tokIdent {
+				return nil, fmt.Errorf("expected field name at offset %d", t.pos)
+			}
+			if p.peek().text != "(" || p.peek().kind != tokOp {
+				x = &fieldNode{x: x, name: t.text}
+				continue
+			}
+			x, err = p.call(x, t.text)
+		case p.accept("["):
+			var idx node
+			if idx, err = p.expr(); err == nil {
+				err = p.expect("]")
+				x = &indexNode{x: x, index: idx}
+			}
+		default:
+			return x, nil
+		}
+	}
+	return nil, err
+}
+
+// call parses the argument list of a call to name on recv, which is nil for global functions.
+func (p *exprParser) call(recv node, name string) (node, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	if _, ok := macros[name]; ok && recv != nil {
+		t := p.next()
+		if t.kind != tokIdent {
+			return nil, fmt.Errorf("%s: expected variable name at offset %d", name, t.pos)
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+		body, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		return &macroNode{recv: recv, kind: name, name: t.text, body: body}, p.expect(")")
+	}
+	args, err := p.list(")")
+	if err != nil {
+		return nil, err
+	}
+	return &callNode{recv: recv, name: name, args: args}, nil
+}
+
+// list parses comma-separated expressions up to the closing operator.
+func (p *exprParser) list(closing string) ([]node, error) {
+	var elems []node
+	if p.accept(closing) {
+		return elems, nil
+	}
+	for {
+		e, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, e)
+		if p.accept(closing) {
+			return elems, nil
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *exprParser) primary() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokInt:
+		v, err := strconv.ParseInt(t.text, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer %q", t.text)
+		}
+		return &literalNode{value: v}, nil
+	case tokString:
+		return &literalNode{value: t.text}, nil
+	case tokIdent:
+		switch t.text {
+		case "true":
+			return &literalNode{value: true}, nil
+		case "false":
+			return &literalNode{value: false}, nil
+		case "null":
+			return &literalNode{value: nil}, nil
+		case "in":
+			return nil, fmt.Errorf("unexpected \"in\" at offset %d", t.pos)
+		}
+		if p.peek().kind == tokOp && p.peek().text == "(" {
+			return p.call(nil, t.text)
+		}
+		return &identNode{name: t.text}, nil
+	case tokOp:
+		switch t.text {
+		case "(":
+			x, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			return x, p.expect(")")
+		case "[":
+			elems, err := p.list("]")
+			if err != nil {
+				return nil, err
+			}
+			return &listNode{elems: elems}, nil
+		}
+	case tokEOF:
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	return nil, fmt.Errorf("unexpected %q at offset %d", t.text, t.pos)
+}
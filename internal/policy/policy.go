@@ -0,0 +1,124 @@
+// file: internal/policy/policy.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+
+// Package policy implements a small, sandboxed expression language for
+// writing lint policies over a Dockerfile.
+//
+// Expressions combine literals (strings, integers, true, false, null and
+// [lists]) with the operators ! - && || == != < <= > >= and in, field access
+// (stage.name), indexing (stage.labels["org.acme.team"]) and calls:
+//
+//   - len(x) returns the length of a string, list or map.
+//   - Strings have lower(), upper(), startsWith(s), endsWith(s), contains(s),
+//     split(sep) and matches(regexp).
+//   - Lists have contains(x) and the macros exists(v, pred), all(v, pred) and
+//     filter(v, pred), which bind each element to v.
+//   - Model objects expose function fields such as stage.has("USER").
+//
+// Evaluation has no side effects and no access to the environment, the file
+// system or the network, and is bounded in source length, nesting depth and
+// evaluation steps. The model exposed to expressions is built by Document,
+// Stage and Instruction.
+package policy
+
+import "fmt"
+
+// Expr is a compiled policy expression. It is safe for concurrent use.
+type Expr struct {
+	src  string
+	root node
+}
+
+// Compile parses src and verifies that it only references the given variables.
+func Compile(src string, vars []string) (*Expr, error) {
+	root, err := parse(src)
+	if err != nil {
+		return nil, err
+	}
+	bound := map[string]int{}
+	for _, v := range vars {
+		bound[v]++
+	}
+	if err := checkIdents(root, bound); err != nil {
+		return nil, err
+	}
+	return &Expr{src: src, root: root}, nil
+}
+
+// String returns the expression source.
+func (x *Expr) String() string { return x.src }
+
+// Eval evaluates the expression with the given variables.
+func (x *Expr) Eval(vars map[string]Value) (Value, error) {
+	scope := make(map[string]Value, len(vars))
+	for k, v := range vars {
+		scope[k] = v
+	}
+	e := &evaluator{vars: scope}
+	return e.eval(x.root)
+}
+
+// EvalBool evaluates the expression and requires a bool result.
+func (x *Expr) EvalBool(vars map[string]Value) (bool, error) {
+	v, err := x.Eval(vars)
+	if err != nil {
+		return false, err
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("expression must yield bool, got %s", typeName(v))
+	}
+	return b, nil
+}
+
+// checkIdents reports the first variable that is neither given nor bound by a macro.
+func checkIdents(n node, bound map[string]int) error {
+	switch n := n.(type) {
+	case *identNode:
+		if bound[n.name] == 0 {
+			return fmt.Errorf("undefined variable %q", n.name)
+		}
+	case *listNode:
+		for _, el := range n.elems {
+			if err := checkIdents(el, bound); err != nil {
+				return err
+			}
+		}
+	case *unaryNode:
+		return checkIdents(n.x, bound)
+	case *binaryNode:
+		if err := checkIdents(n.x, bound); err != nil {
+			return err
+		}
+		return checkIdents(n.y, bound)
+	case *fieldNode:
+		return checkIdents(n.x, bound)
+	case *indexNode:
+		if err := checkIdents(n.x, bound); err != nil {
+			return err
+		}
+		return checkIdents(n.index, bound)
+	case *callNode:
+		if n.recv == nil && n.name != "len" {
+			return fmt.Errorf("unknown function %q", n.name)
+		}
+		if n.recv != nil {
+			if err := checkIdents(n.recv, bound); err != nil {
+				return err
+			}
+		}
+		for _, a := range n.args {
+			if err := checkIdents(a, bound); err != nil {
+				return err
+			}
+		}
+	case *macroNode:
+		if err := checkIdents(n.recv, bound); err != nil {
+			return err
+		}
+		bound[n.name]++
+		defer func() { bound[n.name]-- }()
+		return checkIdents(n.body, bound)
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+// file: internal/policy/policy_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package policy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// TestEval covers operators, methods and macros.
+func TestEval(t *testing.T) {
+	vars := map[string]Value{
+		"s":    "ghcr.io/acme",
+		"n":    int64(3),
+		"list": []Value{"a", "b", int64(1)},
+		"m":    map[string]Value{"k": "v", "x.y": int64(2)},
+	}
+	cases := map[string]Value{
+		`s.startsWith("ghcr.io/") && !s.endsWith("x")`:   true,
+		`s.matches("^ghcr\\.io/") || false`:              true,
+		`'it\'s' == "it's"`:                              true,
+		`n >= 3 && n < 4 && -n < 0`:                      true,
+		`"a" in list && 1 in list && !("c" in list)`:     true,
+		`"k" in m && m["x.y"] == 2 && m["nope"] == null`: true,
+		`"acme" in s`: true,
+		`len(list) == 3 && len(s.split("/")) == 2`: true,
+		`list.exists(x, x == "b")`:                 true,
+		`list.all(x, x != "c")`:                    true,
+		`list.filter(x, x == "a" || x == 1)`:       []Value{"a", int64(1)},
+		`[1, 2][-1]`:                               int64(2),
+		`m.k.upper()`:                              "V",
+		`false && undefinedFn`:                     false,
+	}
+	for src, want := range cases {
+		names := []string{"s", "n", "list", "m", "undefinedFn"}
+		x, err := Compile(src, names)
+		if err != nil {
+			t.Fatalf("%s: compile: %v", src, err)
+		}
+		got, err := x.Eval(vars)
+		if err != nil {
+			t.Fatalf("%s: eval: %v", src, err)
+		}
+		if !equal(got, want) {
+			t.Fatalf("%s: got %v want %v", src, got, want)
+		}
+	}
+}
+
+// TestCompileErrors verifies syntax and scope errors.
+func TestCompileErrors(t *testing.T) {
+	cases := map[string]string{
+		`a - b`:                          `unexpected "-"`,
+		`a &&`:                           "unexpected end",
+		`a == b == c`:                    `unexpected "=="`,
+		`"open`:                          "unterminated string",
+		`a # b`:                          `unexpected '#'`,
+		`unknown`:                        `undefined variable "unknown"`,
+		`exec("rm")`:                     `unknown function "exec"`,
+		`a.exists(1, true)`:              "expected variable name",
+		`(((((a)`:                        `expected ")"`,
+		strings.Repeat("!", 100) + "a":   "nested deeper",
+		strings.Repeat("a", maxSource+1): "longer than",
+	}
+	for src, want := range cases {
+		if _, err := Compile(src, []string{"a", "b", "c"}); err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("%.40s: expected %q error, got %v", src, want, err)
+		}
+	}
+}
+
+// TestEvalErrors verifies type errors and the step budget.
+func TestEvalErrors(t *testing.T) {
+	big := make([]Value, maxSteps)
+	vars := map[string]Value{"s": "x", "n": int64(1), "big": big}
+	cases := map[string]string{
+		`s && true`:                "needs bool",
+		`s < n`:                    "cannot compare",
+		`s.nope()`:                 "no method",
+		`n.x`:                      "cannot select field",
+		`big.exists(x, x != null)`: "exceeded",
+		`s`:                        "must yield bool",
+		`s.matches("(")`:           "matches",
+	}
+	for src, want := range cases {
+		x, err := Compile(src, []string{"s", "n", "big"})
+		if err != nil {
+			t.Fatalf("%s: compile: %v", src, err)
+		}
+		if _, err := x.EvalBool(vars); err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("%s: expected %q error, got %v", src, want, err)
+		}
+	}
+}
+
+// TestIntegrationModel verifies the document model exposed to expressions.
+func TestIntegrationModel(t *testing.T) {
+	src := "ARG REG=ghcr.io\n" +
+		"FROM ${REG}/acme/base:1@sha256:abc AS base\n" +
+		"LABEL org.acme.team=core\n" +
+		"USER app\n" +
+		"FROM base\n" +
+		"RUN apt-get update && /usr/bin/curl -fsSL https://x\n"
+	res, err := parser.Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	d, err := ir.BuildDocument("Dockerfile", res.AST)
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	doc := Document(d)
+	stages := doc["stages"].([]Value)
+	vars := map[string]Value{"document": doc, "base": stages[0], "final": stages[1]}
+	checks := []string{
+		`base.from.registry == "ghcr.io" && base.from.digest == "sha256:abc" && base.from.tag == "1" && base.from.raw.startsWith("${REG}")`,
+		`final.isFinal && !base.isFinal && final.from.isStage && final.parent == 0`,
+		`final.has("USER") && final.user == "app" && final.labels["org.acme.team"] == "core"`,
+		`final.instructions[0].commands.exists(c, c.name == "curl" && "-fsSL" in c.args && c.line == 6)`,
+		`document.args["REG"] == "ghcr.io" && document.target == 1 && len(document.stages) == 2`,
+	}
+	for _, c := range checks {
+		x, err := Compile(c, []string{"document", "base", "final"})
+		if err != nil {
+			t.Fatalf("%s: compile: %v", c, err)
+		}
+		if ok, err := x.EvalBool(vars); err != nil || !ok {
+			t.Fatalf("%s: got %v, %v", c, ok, err)
+		}
+	}
+}
@@ -22,6 +22,19 @@ func NewNoInlineIgnore() engine.Rule { return noInlineIgnore{} }
 // ID returns the rule identifier.
 func (noInlineIgnore) ID() string { return "DL1001" }
 
+// Metadata describes the rule.
+func (noInlineIgnore) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Avoid inline ignore pragmas",
+		Description: "Inline `# hadolint ignore=DLxxxx` directives disable lint rules and should be avoided to ensure all checks run.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"pragma"},
+		Severity:    engine.SeverityInfo,
+		OptIn:       true,
+		DocsURL:     docsURL("DL1001"),
+	}
+}
+
 // Check scans comments and instructions for hadolint ignore pragmas.
 func (noInlineIgnore) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
@@ -23,6 +23,18 @@ func NewAbsoluteWorkdir() engine.Rule { return absoluteWorkdir{} }
 // ID returns the rule identifier.
 func (absoluteWorkdir) ID() string { return "DL3000" }
 
+// Metadata describes the rule.
+func (absoluteWorkdir) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Use absolute WORKDIR",
+		Description: "Ensure WORKDIR paths are absolute so subsequent commands run in a predictable directory.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"workdir"},
+		Severity:    engine.SeverityError,
+		DocsURL:     docsURL("DL3000"),
+	}
+}
+
 // Check examines WORKDIR instructions for absolute paths.
 func (absoluteWorkdir) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
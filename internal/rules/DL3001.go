@@ -19,6 +19,18 @@ func NewNoIrrelevantCommands() engine.Rule { return noIrrelevantCommands{} }
 // ID returns the rule identifier.
 func (noIrrelevantCommands) ID() string { return "DL3001" }
 
+// Metadata describes the rule.
+func (noIrrelevantCommands) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Avoid irrelevant shell commands",
+		Description: "Certain shell commands such as `ssh`, `vim`, `shutdown`, `service`, `ps`, `free`, `top`, `kill`, `mount`, and `ifconfig` typically serve no purpose inside containers.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"run", "shell"},
+		Severity:    engine.SeverityInfo,
+		DocsURL:     docsURL("DL3001"),
+	}
+}
+
 // Check inspects RUN instructions for disallowed commands.
 func (noIrrelevantCommands) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
@@ -29,17 +41,14 @@ func (noIrrelevantCommands) Check(ctx context.Context, d *ir.Document) ([]engine
 		"ssh": {}, "vim": {}, "shutdown": {}, "service": {}, "ps": {},
 		"free": {}, "top": {}, "kill": {}, "mount": {}, "ifconfig": {},
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		cmds := extractCommands(n)
-		for _, c := range cmds {
-			if _, bad := invalid[c]; bad {
+	for _, n := range d.Instructions("run") {
+		for _, c := range d.RunCommands(n) {
+			name := strings.ToLower(c.Argv[0])
+			if _, bad := invalid[name]; bad {
 				findings = append(findings, engine.Finding{
 					RuleID:  "DL3001",
 					Message: "For some bash commands it makes no sense running them in a Docker container like `ssh`, `vim`, `shutdown`, `service`, `ps`, `free`, `top`, `kill`, `mount`, `ifconfig`.",
-					Line:    n.StartLine,
+					Line:    c.Line,
 				})
 				break
 			}
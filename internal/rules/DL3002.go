@@ -13,7 +13,7 @@ import (
 	"github.com/asymmetric-effort/docker-lint/internal/ir"
 )
 
-// lastUserNotRoot ensures the final USER in each stage is non-root.
+// lastUserNotRoot ensures the final USER of the target stage is non-root.
 type lastUserNotRoot struct{}
 
 // NewLastUserNotRoot constructs the rule.
@@ -22,48 +22,51 @@ func NewLastUserNotRoot() engine.Rule { return lastUserNotRoot{} }
 // ID returns the rule identifier.
 func (lastUserNotRoot) ID() string { return "DL3002" }
 
-// Check verifies that the last USER instruction per stage is non-root.
+// Metadata describes the rule.
+func (lastUserNotRoot) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Last USER should not be root",
+		Description: "The effective `USER` of the target stage should not be a root user (`root` or `0`).",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"user", "root"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3002"),
+	}
+}
+
+// Check verifies that the effective USER of the target stage is non-root.
+//
+// The last USER is taken from the target stage, falling back to the stages it
+// inherits from via FROM when the target does not set one.
 func (lastUserNotRoot) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	children := d.AST.Children
-	for i, stage := range d.Stages {
-		start := -1
-		end := len(children)
-		for idx, n := range children {
-			if n == stage.Node {
-				start = idx
-				break
-			}
-		}
-		if start == -1 {
-			continue
-		}
-		if i+1 < len(d.Stages) {
-			for idx, n := range children {
-				if n == d.Stages[i+1].Node {
-					end = idx
-					break
-				}
-			}
-		}
+	target := d.TargetStage()
+	if target == nil {
+		return findings, nil
+	}
+	for _, st := range stageLineage(d, target) {
 		last := ""
 		line := 0
-		for _, n := range children[start+1 : end] {
+		for _, n := range st.Instructions {
 			if strings.EqualFold(n.Value, "user") && n.Next != nil {
 				last = n.Next.Value
 				line = n.StartLine
 			}
 		}
-		if last != "" && isRootUser(last) {
+		if last == "" {
+			continue
+		}
+		if isRootUser(last) {
 			findings = append(findings, engine.Finding{
 				RuleID:  "DL3002",
 				Message: "Last USER should not be root",
 				Line:    line,
 			})
 		}
+		break
 	}
 	return findings, nil
 }
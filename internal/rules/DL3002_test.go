@@ -19,9 +19,9 @@ func TestIntegrationLastUserNotRootID(t *testing.T) {
 	}
 }
 
-// TestIntegrationLastUserNotRootViolation detects stages ending with root user.
+// TestIntegrationLastUserNotRootViolation detects a target stage inheriting the root user.
 func TestIntegrationLastUserNotRootViolation(t *testing.T) {
-	src := "FROM alpine\nUSER root\nRUN echo hi\nFROM busybox\nUSER 0:0\nFROM scratch\nUSER app\n"
+	src := "FROM alpine AS base\nUSER root\nRUN echo hi\nFROM busybox\nUSER 0:0\nFROM base\nRUN echo hi\n"
 	res, err := parser.Parse(strings.NewReader(src))
 	if err != nil {
 		t.Fatalf("parse failed: %v", err)
@@ -35,8 +35,39 @@ func TestIntegrationLastUserNotRootViolation(t *testing.T) {
 	if err != nil {
 		t.Fatalf("check failed: %v", err)
 	}
-	if len(findings) != 2 || findings[0].Line != 2 || findings[1].Line != 5 {
-		t.Fatalf("expected findings on lines 2 and 5, got %#v", findings)
+	if len(findings) != 1 || findings[0].Line != 2 {
+		t.Fatalf("expected finding on line 2, got %#v", findings)
+	}
+}
+
+// TestIntegrationLastUserNotRootTarget evaluates the stage selected with SetTarget.
+func TestIntegrationLastUserNotRootTarget(t *testing.T) {
+	src := "FROM alpine\nUSER root\nFROM busybox AS runtime\nUSER 0:0\nFROM scratch\nUSER app\n"
+	res, err := parser.Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	doc, err := ir.BuildDocument("Dockerfile", res.AST)
+	if err != nil {
+		t.Fatalf("build document: %v", err)
+	}
+	r := NewLastUserNotRoot()
+	findings, err := r.Check(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("check failed: %v", err)
+	}
+	if len(findings) != 0 {
+		t.Fatalf("expected no findings for final stage, got %#v", findings)
+	}
+	if err := doc.SetTarget("runtime"); err != nil {
+		t.Fatalf("set target: %v", err)
+	}
+	findings, err = r.Check(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("check failed: %v", err)
+	}
+	if len(findings) != 1 || findings[0].Line != 4 {
+		t.Fatalf("expected finding on line 4, got %#v", findings)
 	}
 }
 
@@ -22,23 +22,32 @@ func NewUseWorkdir() engine.Rule { return useWorkdir{} }
 // ID returns the rule identifier.
 func (useWorkdir) ID() string { return "DL3003" }
 
+// Metadata describes the rule.
+func (useWorkdir) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Use WORKDIR instead of cd",
+		Description: "Using `cd` in `RUN` instructions is error-prone; prefer the `WORKDIR` instruction to change directories.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"run", "workdir"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3003"),
+	}
+}
+
 // Check scans RUN instructions for cd usage.
 func (useWorkdir) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		cmds := extractCommands(n)
-		for _, c := range cmds {
-			if c == "cd" {
+	for _, n := range d.Instructions("run") {
+		for _, c := range d.RunCommands(n) {
+			name := strings.ToLower(c.Argv[0])
+			if name == "cd" {
 				findings = append(findings, engine.Finding{
 					RuleID:  "DL3003",
 					Message: "Use WORKDIR to switch to a directory",
-					Line:    n.StartLine,
+					Line:    c.Line,
 				})
 				break
 			}
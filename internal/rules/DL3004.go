@@ -22,23 +22,32 @@ func NewNoSudo() engine.Rule { return noSudo{} }
 // ID returns the rule identifier.
 func (noSudo) ID() string { return "DL3004" }
 
+// Metadata describes the rule.
+func (noSudo) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Do not use sudo",
+		Description: "`sudo` in `RUN` instructions leads to unpredictable behavior; use tools like `gosu` instead.",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"run", "sudo", "root"},
+		Severity:    engine.SeverityError,
+		DocsURL:     docsURL("DL3004"),
+	}
+}
+
 // Check scans RUN instructions for sudo usage.
 func (noSudo) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		cmds := extractCommands(n)
-		for _, c := range cmds {
-			if c == "sudo" {
+	for _, n := range d.Instructions("run") {
+		for _, c := range d.RunCommands(n) {
+			name := strings.ToLower(c.Argv[0])
+			if name == "sudo" {
 				findings = append(findings, engine.Finding{
 					RuleID:  "DL3004",
 					Message: "Do not use sudo as it leads to unpredictable behavior. Use a tool like gosu to enforce root",
-					Line:    n.StartLine,
+					Line:    c.Line,
 				})
 				break
 			}
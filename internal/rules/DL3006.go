@@ -22,6 +22,18 @@ func NewRequireTag() engine.Rule { return requireTag{} }
 // ID returns the rule identifier.
 func (requireTag) ID() string { return "DL3006" }
 
+// Metadata describes the rule.
+func (requireTag) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Always tag the version of an image explicitly",
+		Description: "Specify an explicit tag or digest for images in `FROM` instructions. Untagged images can lead to unpredictable builds.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"from", "pinning"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3006"),
+	}
+}
+
 // Check examines stage base images for explicit tags.
 func (requireTag) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
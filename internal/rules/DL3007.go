@@ -21,6 +21,18 @@ func NewNoLatestTag() engine.Rule { return noLatestTag{} }
 // ID returns the rule identifier.
 func (noLatestTag) ID() string { return "DL3007" }
 
+// Metadata describes the rule.
+func (noLatestTag) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Avoid latest tag",
+		Description: "`FROM` instructions should specify a version or digest instead of relying on the implicit or `latest` tag. Pinning image versions ensures reproducible builds.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"from", "pinning"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3007"),
+	}
+}
+
 // Check evaluates each stage for usage of latest tags.
 func (noLatestTag) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
@@ -7,7 +7,6 @@ package rules
 
 import (
 	"context"
-	"regexp"
 	"strings"
 
 	"github.com/asymmetric-effort/docker-lint/internal/engine"
@@ -23,57 +22,50 @@ func NewAptPin() engine.Rule { return aptPin{} }
 // ID returns the rule identifier.
 func (aptPin) ID() string { return "DL3008" }
 
+// Metadata describes the rule.
+func (aptPin) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Pin versions in apt-get install",
+		Description: "Packages installed with `apt-get` or `apt` should be version pinned to ensure deterministic builds.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"apt", "pinning"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3008"),
+	}
+}
+
 // Check evaluates RUN instructions for unpinned apt installs.
 func (aptPin) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") || n.Next == nil {
+	for _, n := range d.Instructions("run") {
+		if n.Next == nil {
 			continue
 		}
-		cmd := n.Next.Value
-		if hasUnpinnedAptInstall(cmd) {
+		for _, seg := range runSegments(d, n) {
+			if !isUnpinnedAptInstall(seg.Args) {
+				continue
+			}
 			findings = append(findings, engine.Finding{
 				RuleID:  "DL3008",
 				Message: "Pin versions in apt-get install. Instead of 'apt-get install <pkg>' use 'apt-get install <pkg>=<version>'.",
-				Line:    n.StartLine,
+				Line:    seg.Line,
 			})
+			break
 		}
 	}
 	return findings, nil
 }
 
-var splitter = regexp.MustCompile(`\s*(?:&&|\|\||;)\s*`)
-
-func hasUnpinnedAptInstall(cmd string) bool {
-	cmd = strings.ReplaceAll(cmd, "\\\n", " ")
-	cmd = strings.ReplaceAll(cmd, "\n", " ")
-	parts := splitter.Split(cmd, -1)
-	for _, part := range parts {
-		tokens := strings.Fields(part)
-		for i := 0; i < len(tokens); i++ {
-			tok := tokens[i]
-			if tok == "apt-get" || tok == "apt" {
-				for j := i + 1; j < len(tokens); j++ {
-					t := tokens[j]
-					if t == "install" {
-						if unpinnedPackages(tokens[j+1:]) {
-							return true
-						}
-						break
-					}
-					if !strings.HasPrefix(t, "-") {
-						break
-					}
-				}
-			}
-		}
-	}
-	return false
+// isUnpinnedAptInstall reports whether an apt-get or apt install command lists an unpinned package.
+func isUnpinnedAptInstall(tokens []string) bool {
+	args, ok := installArgs(tokens, "apt")
+	return ok && unpinnedPackages(args)
 }
 
+// unpinnedPackages reports whether any non-flag argument lacks a version.
 func unpinnedPackages(args []string) bool {
 	for _, a := range args {
 		if strings.HasPrefix(a, "-") {
@@ -93,3 +93,63 @@ func TestIntegrationAptPinEmptyDocument(t *testing.T) {
 		t.Fatalf("expected no findings, got %d", len(findings))
 	}
 }
+
+// TestIntegrationAptPinShellConstructs ignores redirections and pipes but sees env-prefixed and nested installs.
+func TestIntegrationAptPinShellConstructs(t *testing.T) {
+	cases := map[string]int{
+		"FROM ubuntu\nRUN apt-get install -y curl=7.81.0-1 > /dev/null 2>&1 | tee log\n":            0,
+		"FROM ubuntu\nRUN DEBIAN_FRONTEND=noninteractive apt-get install -y curl\n":                 1,
+		"FROM ubuntu\nRUN if [ -n \"$X\" ]; then (apt-get update && apt-get install -y curl); fi\n": 1,
+		"FROM ubuntu\nRUN echo 'apt-get install curl' && sudo apt-get install -y curl=7.81.0-1\n":   0,
+		"FROM ubuntu\nRUN [\"/bin/sh\", \"-c\", \"apt-get update && apt-get install -y curl\"]\n":   1,
+	}
+	for src, want := range cases {
+		res, err := parser.Parse(strings.NewReader(src))
+		if err != nil {
+			t.Fatalf("parse failed: %v", err)
+		}
+		doc, err := ir.BuildDocument("Dockerfile", res.AST)
+		if err != nil {
+			t.Fatalf("build document: %v", err)
+		}
+		findings, err := NewAptPin().Check(context.Background(), doc)
+		if err != nil {
+			t.Fatalf("check failed: %v", err)
+		}
+		if len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %d", src, want, len(findings))
+		}
+	}
+}
+
+// TestIntegrationAptPinHeredoc checks shell heredoc scripts and reports the heredoc line.
+func TestIntegrationAptPinHeredoc(t *testing.T) {
+	cases := map[string]int{
+		"FROM ubuntu\nRUN <<EOF\napt-get update\napt-get install -y curl\nEOF\n":               4,
+		"FROM ubuntu\nRUN <<-EOF\n\t#!/bin/bash\n\tapt-get install -y curl\n\tEOF\n":           4,
+		"FROM ubuntu\nRUN bash -ex <<EOF\napt-get install -y curl\nEOF\n":                      3,
+		"FROM ubuntu\nRUN <<EOF\n#!/usr/bin/env python3\nprint('apt-get install curl')\nEOF\n": 0,
+		"FROM ubuntu\nRUN cat <<EOF > /tmp/notes\napt-get install curl\nEOF\n":                 0,
+		"FROM ubuntu\nCOPY <<EOF /install.sh\napt-get install curl\nEOF\n":                     0,
+	}
+	for src, wantLine := range cases {
+		res, err := parser.Parse(strings.NewReader(src))
+		if err != nil {
+			t.Fatalf("parse failed: %v", err)
+		}
+		doc, err := ir.BuildDocument("Dockerfile", res.AST)
+		if err != nil {
+			t.Fatalf("build document: %v", err)
+		}
+		findings, err := NewAptPin().Check(context.Background(), doc)
+		if err != nil {
+			t.Fatalf("check failed: %v", err)
+		}
+		switch {
+		case wantLine == 0 && len(findings) != 0:
+			t.Fatalf("%q: expected no findings, got %#v", src, findings)
+		case wantLine != 0 && (len(findings) != 1 || findings[0].Line != wantLine):
+			t.Fatalf("%q: expected one finding on line %d, got %#v", src, wantLine, findings)
+		}
+	}
+}
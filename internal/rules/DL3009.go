@@ -22,17 +22,26 @@ func NewAptListsCleanup() engine.Rule { return aptListsCleanup{} }
 // ID returns the rule identifier.
 func (aptListsCleanup) ID() string { return "DL3009" }
 
+// Metadata describes the rule.
+func (aptListsCleanup) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Delete the APT lists after installing packages",
+		Description: "After installing packages with `apt-get` or `apt`, remove `/var/lib/apt/lists` in the same layer to keep images small and reduce attack surface.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"apt", "image-size"},
+		Severity:    engine.SeverityInfo,
+		DocsURL:     docsURL("DL3009"),
+	}
+}
+
 // Check scans RUN instructions for apt installs lacking cleanup.
 func (aptListsCleanup) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		segments := lowerSegments(splitRunSegments(n))
+	for _, n := range d.Instructions("run") {
+		segments := splitRunSegments(d, n)
 		if needsAptListsCleanup(segments) {
 			findings = append(findings, engine.Finding{
 				RuleID:  "DL3009",
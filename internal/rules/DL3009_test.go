@@ -113,3 +113,23 @@ func TestIntegrationAptListsCleanupNil(t *testing.T) {
 		t.Fatalf("expected no findings on empty doc: %v %v", findings, err)
 	}
 }
+
+// TestIntegrationAptListsCleanupEnvPrefix detects installs prefixed with environment assignments.
+func TestIntegrationAptListsCleanupEnvPrefix(t *testing.T) {
+	src := "FROM ubuntu\nRUN DEBIAN_FRONTEND=noninteractive apt-get install -y curl\nRUN (apt-get update && apt-get install -y vim) && rm -rf /var/lib/apt/lists/*\n"
+	res, err := parser.Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	doc, err := ir.BuildDocument("Dockerfile", res.AST)
+	if err != nil {
+		t.Fatalf("build document: %v", err)
+	}
+	findings, err := NewAptListsCleanup().Check(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("check failed: %v", err)
+	}
+	if len(findings) != 1 || findings[0].Line != 2 {
+		t.Fatalf("expected finding on line 2, got %#v", findings)
+	}
+}
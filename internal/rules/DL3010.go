@@ -23,16 +23,25 @@ func NewUseADDForArchives() engine.Rule { return useADDForArchives{} }
 // ID returns the rule identifier.
 func (useADDForArchives) ID() string { return "DL3010" }
 
+// Metadata describes the rule.
+func (useADDForArchives) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Use ADD for extracting archives into an image",
+		Description: "`ADD` automatically extracts local tar archives when the destination is a directory. Using `COPY` misses this feature.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"add", "archive"},
+		Severity:    engine.SeverityInfo,
+		DocsURL:     docsURL("DL3010"),
+	}
+}
+
 // Check examines COPY instructions that copy local tar archives to directories.
 func (useADDForArchives) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "copy") {
-			continue
-		}
+	for _, n := range d.Instructions("copy") {
 		if hasFromFlag(n.Flags) {
 			continue
 		}
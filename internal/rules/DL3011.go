@@ -23,18 +23,28 @@ func NewValidPortRange() engine.Rule { return validPortRange{} }
 // ID returns the rule identifier.
 func (validPortRange) ID() string { return "DL3011" }
 
-// Check inspects EXPOSE instructions for invalid ports.
+// Metadata describes the rule.
+func (validPortRange) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Valid UNIX ports range from 0 to 65535",
+		Description: "Ports exposed by `EXPOSE` must fall within the valid TCP/UDP range.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"expose"},
+		Severity:    engine.SeverityError,
+		DocsURL:     docsURL("DL3011"),
+	}
+}
+
+// Check inspects EXPOSE instructions for invalid ports, substituting build
+// variables where their values are known.
 func (validPortRange) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "expose") {
-			continue
-		}
-		for arg := n.Next; arg != nil; arg = arg.Next {
-			if !portInRange(arg.Value) {
+	for _, n := range d.Instructions("expose") {
+		for _, port := range exposedPorts(d, n) {
+			if !portInRange(port) {
 				findings = append(findings, engine.Finding{
 					RuleID:  "DL3011",
 					Message: "Valid UNIX ports range from 0 to 65535",
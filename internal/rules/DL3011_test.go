@@ -92,3 +92,18 @@ func TestIntegrationValidPortRangeNilDocument(t *testing.T) {
 		t.Fatalf("expected no findings on empty doc: %v %v", f, err)
 	}
 }
+
+// TestIntegrationValidPortRangeVariables checks ports resolved from ARG and ENV.
+func TestIntegrationValidPortRangeVariables(t *testing.T) {
+	cases := map[string]int{
+		"FROM alpine\nARG PORT=70000\nEXPOSE $PORT\n":            1,
+		"FROM alpine\nENV PORTS=\"80 99999\"\nEXPOSE ${PORTS}\n": 1,
+		"FROM alpine\nARG PORT=8080\nEXPOSE ${PORT}/udp\n":       0,
+		"FROM alpine\nARG PORT\nEXPOSE $PORT\n":                  0,
+	}
+	for src, want := range cases {
+		if findings := checkRule(t, NewValidPortRange(), src); len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %#v", src, want, findings)
+		}
+	}
+}
@@ -22,6 +22,18 @@ func NewSingleHealthcheck() engine.Rule { return singleHealthcheck{} }
 // ID returns the rule identifier.
 func (singleHealthcheck) ID() string { return "DL3012" }
 
+// Metadata describes the rule.
+func (singleHealthcheck) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Multiple HEALTHCHECK instructions",
+		Description: "Each build stage may define at most one `HEALTHCHECK`. Additional `HEALTHCHECK` instructions override previous ones and can be confusing.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"healthcheck"},
+		Severity:    engine.SeverityError,
+		DocsURL:     docsURL("DL3012"),
+	}
+}
+
 // Check verifies that each stage contains at most one HEALTHCHECK instruction.
 func (singleHealthcheck) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
@@ -9,8 +9,6 @@ import (
 	"context"
 	"strings"
 
-	"github.com/google/shlex"
-
 	"github.com/asymmetric-effort/docker-lint/internal/engine"
 	"github.com/asymmetric-effort/docker-lint/internal/ir"
 )
@@ -24,27 +22,34 @@ func NewPinPipVersions() engine.Rule { return pinPipVersions{} }
 // ID returns the rule identifier.
 func (pinPipVersions) ID() string { return "DL3013" }
 
+// Metadata describes the rule.
+func (pinPipVersions) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Pin versions in pip",
+		Description: "Always pin versions when installing Python packages with `pip` to ensure reproducible builds. Use `pip install <package>==<version>` or install from a requirements file.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"pip", "pinning"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3013"),
+	}
+}
+
 // Check inspects RUN instructions for unpinned pip installs.
 func (pinPipVersions) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") || n.Next == nil {
+	for _, n := range d.Instructions("run") {
+		if n.Next == nil {
 			continue
 		}
-		tokens, err := shlex.Split(n.Next.Value)
-		if err != nil {
-			continue
-		}
-		cmds := splitRunCommands(tokens)
-		for _, cmd := range cmds {
-			if violatesPipPin(cmd) {
+		for _, seg := range runSegments(d, n) {
+			if violatesPipPin(seg.Args) {
 				findings = append(findings, engine.Finding{
 					RuleID:  "DL3013",
 					Message: "Pin versions in pip. Instead of `pip install <package>` use `pip install <package>==<version>` or `pip install --requirement <requirements file>`",
-					Line:    n.StartLine,
+					Line:    seg.Line,
 				})
 				break
 			}
@@ -53,27 +58,6 @@ func (pinPipVersions) Check(ctx context.Context, d *ir.Document) ([]engine.Findi
 	return findings, nil
 }
 
-// splitRunCommands divides tokens into individual commands based on shell connectors.
-func splitRunCommands(tokens []string) [][]string {
-	connectors := map[string]struct{}{"&&": {}, "||": {}, "|": {}, ";": {}}
-	var result [][]string
-	var current []string
-	for _, tok := range tokens {
-		if _, ok := connectors[tok]; ok {
-			if len(current) > 0 {
-				result = append(result, current)
-				current = nil
-			}
-			continue
-		}
-		current = append(current, tok)
-	}
-	if len(current) > 0 {
-		result = append(result, current)
-	}
-	return result
-}
-
 // violatesPipPin reports whether a pip install command lacks version pinning.
 func violatesPipPin(cmd []string) bool {
 	start, ok := pipInstallIndex(cmd)
@@ -6,21 +6,6 @@ import (
 	"testing"
 )
 
-// TestSplitRunCommands covers token splitting with connectors.
-func TestSplitRunCommands(t *testing.T) {
-	tokens := []string{"pip", "install", "a", "&&", "pip", "install", "b", ";", "echo", "done"}
-	cmds := splitRunCommands(tokens)
-	if len(cmds) != 3 {
-		t.Fatalf("expected 3 commands, got %d", len(cmds))
-	}
-	if len(cmds[0]) != 3 || len(cmds[1]) != 3 || len(cmds[2]) != 2 {
-		t.Fatalf("unexpected command segments: %#v", cmds)
-	}
-	if c := splitRunCommands([]string{"echo", "hi"}); len(c) != 1 {
-		t.Fatalf("expected single command, got %d", len(c))
-	}
-}
-
 // TestPipInstallIndex exercises pipInstallIndex for various forms.
 func TestPipInstallIndex(t *testing.T) {
 	if idx, ok := pipInstallIndex([]string{"pip", "install", "pkg"}); !ok || idx != 2 {
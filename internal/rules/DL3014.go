@@ -7,9 +7,6 @@ package rules
 
 import (
 	"context"
-	"strings"
-
-	"github.com/google/shlex"
 
 	"github.com/asymmetric-effort/docker-lint/internal/engine"
 	"github.com/asymmetric-effort/docker-lint/internal/ir"
@@ -24,27 +21,32 @@ func NewAptGetYes() engine.Rule { return aptGetYes{} }
 // ID returns the rule identifier.
 func (aptGetYes) ID() string { return "DL3014" }
 
+// Metadata describes the rule.
+func (aptGetYes) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Use the -y switch for apt-get install",
+		Description: "Include a non-interactive flag when running `apt-get install` in `RUN` instructions. Use `-y`, `--yes`, `--assume-yes`, or an equivalent option such as `-qq` to avoid manual prompts during package installation.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"apt", "non-interactive"},
+		Severity:    engine.SeverityWarning,
+		Fixable:     true,
+		DocsURL:     docsURL("DL3014"),
+	}
+}
+
 // Check scans RUN instructions for apt-get install lacking -y or equivalent.
 func (aptGetYes) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		tokens, err := shlex.Split(n.Next.Value)
-		if err != nil {
-			continue
-		}
-		segments := splitByConnectors(tokens)
-		for _, seg := range segments {
-			if isAptGetInstall(seg) && !hasYesOption(seg) {
+	for _, n := range d.Instructions("run") {
+		for _, seg := range runSegments(d, n) {
+			if isAptGetInstall(seg.Args) && !hasYesOption(seg.Args) {
 				findings = append(findings, engine.Finding{
 					RuleID:  "DL3014",
 					Message: "Use the -y switch to avoid manual input apt-get -y install <package>",
-					Line:    n.StartLine,
+					Line:    seg.Line,
 				})
 				break
 			}
@@ -53,27 +55,6 @@ func (aptGetYes) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, e
 	return findings, nil
 }
 
-// splitByConnectors divides tokens into command segments.
-func splitByConnectors(tokens []string) [][]string {
-	var segments [][]string
-	var current []string
-	for _, t := range tokens {
-		switch t {
-		case "&&", "||", "|", ";":
-			if len(current) > 0 {
-				segments = append(segments, current)
-				current = nil
-			}
-		default:
-			current = append(current, strings.ToLower(t))
-		}
-	}
-	if len(current) > 0 {
-		segments = append(segments, current)
-	}
-	return segments
-}
-
 // isAptGetInstall reports whether tokens represent `apt-get install`.
 func isAptGetInstall(tokens []string) bool {
 	if len(tokens) == 0 {
@@ -9,9 +9,6 @@ import (
 	"context"
 	"strings"
 
-	"github.com/google/shlex"
-	"github.com/moby/buildkit/frontend/dockerfile/parser"
-
 	"github.com/asymmetric-effort/docker-lint/internal/engine"
 	"github.com/asymmetric-effort/docker-lint/internal/ir"
 )
@@ -25,24 +22,32 @@ func NewAptNoInstallRecommends() engine.Rule { return aptNoInstallRecommends{} }
 // ID returns the rule identifier.
 func (aptNoInstallRecommends) ID() string { return "DL3015" }
 
+// Metadata describes the rule.
+func (aptNoInstallRecommends) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Use --no-install-recommends with apt-get",
+		Description: "The `apt-get install` command installs additional recommended packages by default. Use the `--no-install-recommends` flag or set `APT::Install-Recommends=false` to avoid pulling unnecessary dependencies.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"apt", "image-size"},
+		Severity:    engine.SeverityInfo,
+		Fixable:     true,
+		DocsURL:     docsURL("DL3015"),
+	}
+}
+
 // Check scans RUN instructions for apt-get install missing --no-install-recommends.
 func (aptNoInstallRecommends) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		tokens := runTokens(n)
-		cmds := splitTokens(tokens)
-		for _, c := range cmds {
-			if aptInstallMissingFlag(c) {
+	for _, n := range d.Instructions("run") {
+		for _, seg := range runSegments(d, n) {
+			if aptInstallMissingFlag(seg.Args) {
 				findings = append(findings, engine.Finding{
 					RuleID:  "DL3015",
 					Message: "Avoid additional packages by specifying `--no-install-recommends`",
-					Line:    n.StartLine,
+					Line:    seg.Line,
 				})
 				break
 			}
@@ -51,46 +56,6 @@ func (aptNoInstallRecommends) Check(ctx context.Context, d *ir.Document) ([]engi
 	return findings, nil
 }
 
-// runTokens returns shell tokens for a RUN instruction.
-func runTokens(n *parser.Node) []string {
-	if n == nil || n.Next == nil {
-		return nil
-	}
-	if n.Attributes != nil && n.Attributes["json"] {
-		var toks []string
-		for c := n.Next; c != nil; c = c.Next {
-			toks = append(toks, c.Value)
-		}
-		return toks
-	}
-	tokens, err := shlex.Split(n.Next.Value)
-	if err != nil {
-		return nil
-	}
-	return tokens
-}
-
-// splitTokens divides tokens into individual commands.
-func splitTokens(tokens []string) [][]string {
-	var cmds [][]string
-	var cur []string
-	for _, t := range tokens {
-		switch t {
-		case "&&", "||", ";", "|":
-			if len(cur) > 0 {
-				cmds = append(cmds, cur)
-				cur = nil
-			}
-		default:
-			cur = append(cur, t)
-		}
-	}
-	if len(cur) > 0 {
-		cmds = append(cmds, cur)
-	}
-	return cmds
-}
-
 // aptInstallMissingFlag reports apt-get install commands lacking no-install-recommends.
 func aptInstallMissingFlag(tokens []string) bool {
 	if len(tokens) == 0 {
@@ -71,3 +71,23 @@ func TestIntegrationAptNoInstallRecommendsNilDocument(t *testing.T) {
 		t.Fatalf("expected no findings on empty doc: %v %v", findings, err)
 	}
 }
+
+// TestIntegrationAptNoInstallRecommendsEnvPrefix detects env-prefixed and malformed RUN scripts.
+func TestIntegrationAptNoInstallRecommendsEnvPrefix(t *testing.T) {
+	src := "FROM ubuntu\nRUN DEBIAN_FRONTEND=noninteractive apt-get install -y curl\nRUN apt-get install -y vim && echo 'oops\n"
+	res, err := parser.Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	doc, err := ir.BuildDocument("Dockerfile", res.AST)
+	if err != nil {
+		t.Fatalf("build document: %v", err)
+	}
+	findings, err := NewAptNoInstallRecommends().Check(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("check failed: %v", err)
+	}
+	if len(findings) != 2 {
+		t.Fatalf("expected 2 findings, got %#v", findings)
+	}
+}
@@ -22,29 +22,34 @@ func NewPinNpmVersion() engine.Rule { return pinNpmVersion{} }
 // ID returns the rule identifier.
 func (pinNpmVersion) ID() string { return "DL3016" }
 
+// Metadata describes the rule.
+func (pinNpmVersion) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Pin versions in npm",
+		Description: "Specify a version, tag, commit, or other explicit reference when installing packages with `npm install`. Unpinned dependencies can lead to unpredictable builds.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"npm", "pinning"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3016"),
+	}
+}
+
 // Check scans RUN instructions for unpinned npm install usage.
 func (pinNpmVersion) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		segments := splitRunSegments(n)
-		for _, seg := range segments {
-			if len(seg) == 0 {
-				continue
-			}
-			if strings.ToLower(seg[0]) != "npm" {
+	for _, n := range d.Instructions("run") {
+		for _, seg := range runSegments(d, n) {
+			if seg.Args[0] != "npm" {
 				continue
 			}
-			if packages := npmInstallPackages(seg); len(packages) > 0 && !allVersionFixed(packages) {
+			if packages := npmInstallPackages(seg.Args); len(packages) > 0 && !allVersionFixed(packages) {
 				findings = append(findings, engine.Finding{
 					RuleID:  "DL3016",
 					Message: "Pin versions in npm. Instead of `npm install <package>` use `npm install <package>@<version>`",
-					Line:    n.StartLine,
+					Line:    seg.Line,
 				})
 			}
 		}
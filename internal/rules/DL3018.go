@@ -7,7 +7,6 @@ package rules
 
 import (
 	"context"
-	"regexp"
 	"strings"
 
 	"github.com/asymmetric-effort/docker-lint/internal/engine"
@@ -23,56 +22,50 @@ func NewApkPin() engine.Rule { return apkPin{} }
 // ID returns the rule identifier.
 func (apkPin) ID() string { return "DL3018" }
 
+// Metadata describes the rule.
+func (apkPin) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Pin versions in apk add",
+		Description: "Ensure packages installed via `apk add` are pinned to a version using `=<version>` or installed from `.apk` files to improve build reproducibility.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"apk", "pinning"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3018"),
+	}
+}
+
 // Check evaluates RUN instructions for unpinned apk adds.
 func (apkPin) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") || n.Next == nil {
+	for _, n := range d.Instructions("run") {
+		if n.Next == nil {
 			continue
 		}
-		cmd := n.Next.Value
-		if hasUnpinnedApkAdd(cmd) {
+		for _, seg := range runSegments(d, n) {
+			if !isUnpinnedApkAdd(seg.Args) {
+				continue
+			}
 			findings = append(findings, engine.Finding{
 				RuleID:  "DL3018",
 				Message: "Pin versions in apk add. Instead of 'apk add <package>' use 'apk add <package>=<version>'.",
-				Line:    n.StartLine,
+				Line:    seg.Line,
 			})
+			break
 		}
 	}
 	return findings, nil
 }
 
-var apkSplitter = regexp.MustCompile(`\s*(?:&&|\|\||;)\s*`)
-
-func hasUnpinnedApkAdd(cmd string) bool {
-	cmd = strings.ReplaceAll(cmd, "\\\n", " ")
-	cmd = strings.ReplaceAll(cmd, "\n", " ")
-	parts := apkSplitter.Split(cmd, -1)
-	for _, part := range parts {
-		tokens := strings.Fields(part)
-		for i := 0; i < len(tokens); i++ {
-			if tokens[i] == "apk" {
-				for j := i + 1; j < len(tokens); j++ {
-					t := tokens[j]
-					if t == "add" {
-						if unpinnedApkPackages(tokens[j+1:]) {
-							return true
-						}
-						break
-					}
-					if !strings.HasPrefix(t, "-") {
-						break
-					}
-				}
-			}
-		}
-	}
-	return false
+// isUnpinnedApkAdd reports whether an apk add command lists an unpinned package.
+func isUnpinnedApkAdd(tokens []string) bool {
+	args, ok := installArgs(tokens, "apk")
+	return ok && unpinnedApkPackages(args)
 }
 
+// unpinnedApkPackages reports whether any non-flag argument lacks a version.
 func unpinnedApkPackages(args []string) bool {
 	for _, a := range args {
 		if strings.HasPrefix(a, "-") {
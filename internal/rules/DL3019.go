@@ -9,8 +9,6 @@ import (
 	"context"
 	"strings"
 
-	"github.com/google/shlex"
-
 	"github.com/asymmetric-effort/docker-lint/internal/engine"
 	"github.com/asymmetric-effort/docker-lint/internal/ir"
 )
@@ -24,33 +22,35 @@ func NewApkNoCache() engine.Rule { return apkNoCache{} }
 // ID returns the rule identifier.
 func (apkNoCache) ID() string { return "DL3019" }
 
+// Metadata describes the rule.
+func (apkNoCache) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Use --no-cache with apk add",
+		Description: "Use the `--no-cache` switch to avoid the need to use `--update` and remove `/var/cache/apk/*` when installing packages with `apk add`.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"apk", "image-size"},
+		Severity:    engine.SeverityInfo,
+		Fixable:     true,
+		DocsURL:     docsURL("DL3019"),
+	}
+}
+
 // Check examines RUN instructions for apk add missing --no-cache and without cache mount.
 func (apkNoCache) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
+	for _, n := range d.Instructions("run") {
 		if hasApkCacheMount(n.Flags) {
 			continue
 		}
-		if n.Next == nil {
-			continue
-		}
-		tokens, err := shlex.Split(n.Next.Value)
-		if err != nil {
-			continue
-		}
-		segments := splitByConnectors(tokens)
-		for _, seg := range segments {
-			if isApkAdd(seg) && !hasNoCache(seg) {
+		for _, seg := range runSegments(d, n) {
+			if isApkAdd(seg.Args) && !hasNoCache(seg.Args) {
 				findings = append(findings, engine.Finding{
 					RuleID:  "DL3019",
 					Message: "Use the `--no-cache` switch to avoid the need to use `--update` and remove `/var/cache/apk/*` when done installing packages",
-					Line:    n.StartLine,
+					Line:    seg.Line,
 				})
 				break
 			}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/asymmetric-effort/docker-lint/internal/engine"
 	"github.com/asymmetric-effort/docker-lint/internal/ir"
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
 )
 
 // useCopyInsteadOfAdd advises using COPY for local files and folders.
@@ -22,23 +23,33 @@ func NewUseCopyInsteadOfAdd() engine.Rule { return useCopyInsteadOfAdd{} }
 // ID returns the rule identifier.
 func (useCopyInsteadOfAdd) ID() string { return "DL3020" }
 
-// Check inspects ADD instructions for local sources that are neither URLs nor archives.
+// Metadata describes the rule.
+func (useCopyInsteadOfAdd) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Use COPY instead of ADD for files and folders",
+		Description: "Use `COPY` for copying local files or directories. `ADD` should be reserved for remote URLs or archives that need automatic extraction.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"add", "copy"},
+		Severity:    engine.SeverityError,
+		Fixable:     true,
+		DocsURL:     docsURL("DL3020"),
+	}
+}
+
+// Check inspects ADD instructions for local sources that are neither URLs, Git repositories nor archives.
 func (useCopyInsteadOfAdd) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "add") {
-			continue
-		}
+	for _, n := range d.Instructions("add") {
 		tokens := collectArgs(n)
 		if len(tokens) < 2 {
 			continue
 		}
 		sources := tokens[:len(tokens)-1]
 		for _, src := range sources {
-			if !isURL(src) && !isArchive(src) {
+			if !isURL(src) && !isGitSource(src) && !isArchive(src) {
 				findings = append(findings, engine.Finding{
 					RuleID:  "DL3020",
 					Message: "Use COPY instead of ADD for files and folders",
@@ -57,6 +68,34 @@ func isURL(p string) bool {
 	return strings.HasPrefix(lp, "http://") || strings.HasPrefix(lp, "https://")
 }
 
+// isGitSource reports whether an ADD source refers to a Git repository:
+// git@host:repo, git:// and ssh:// URLs, and HTTP(S) URLs whose path ends in .git.
+func isGitSource(p string) bool {
+	lp := strings.ToLower(p)
+	if strings.HasPrefix(lp, "git@") || strings.HasPrefix(lp, "git://") || strings.HasPrefix(lp, "ssh://") {
+		return true
+	}
+	return isURL(lp) && strings.HasSuffix(sourcePath(lp), ".git")
+}
+
+// sourcePath returns a source without its URL query and fragment.
+func sourcePath(p string) string {
+	if i := strings.IndexAny(p, "?#"); i >= 0 {
+		return p[:i]
+	}
+	return p
+}
+
+// addFlag returns the value of an ADD or COPY flag such as --checksum.
+func addFlag(n *parser.Node, name string) (string, bool) {
+	for _, f := range n.Flags {
+		if v, ok := strings.CutPrefix(f, "--"+name+"="); ok {
+			return strings.Trim(v, "\"'"), true
+		}
+	}
+	return "", false
+}
+
 var archiveFileExtensions = []string{
 	".tar",
 	".z",
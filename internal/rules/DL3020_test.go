@@ -134,3 +134,12 @@ func TestIntegrationUseCopyInsteadOfAddNilDocument(t *testing.T) {
 		t.Fatalf("expected no findings on empty doc: %v %v", findings, err)
 	}
 }
+
+// TestIntegrationUseCopyInsteadOfAddGit allows ADD of Git repositories.
+func TestIntegrationUseCopyInsteadOfAddGit(t *testing.T) {
+	for _, line := range []string{"ADD git@github.com:moby/buildkit.git /src", "ADD ssh://git@example.com/repo.git#main /src"} {
+		if findings := checkRule(t, NewUseCopyInsteadOfAdd(), "FROM alpine:3.19\n"+line+"\n"); len(findings) != 0 {
+			t.Fatalf("%q: expected no findings, got %#v", line, findings)
+		}
+	}
+}
@@ -22,16 +22,25 @@ func NewCopyDestEndsWithSlash() engine.Rule { return copyDestEndsWithSlash{} }
 // ID returns the rule identifier.
 func (copyDestEndsWithSlash) ID() string { return "DL3021" }
 
+// Metadata describes the rule.
+func (copyDestEndsWithSlash) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Ensure destination ends with slash when copying multiple sources",
+		Description: "COPY instructions that specify more than two arguments must end the destination path with `/` so the engine treats it as a directory.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"copy"},
+		Severity:    engine.SeverityError,
+		DocsURL:     docsURL("DL3021"),
+	}
+}
+
 // Check verifies that COPY with more than 2 arguments uses a destination ending with '/'.
 func (copyDestEndsWithSlash) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "copy") {
-			continue
-		}
+	for _, n := range d.Instructions("copy") {
 		tokens := collectArgs(n)
 		if len(tokens) <= 2 {
 			continue
@@ -20,6 +20,18 @@ func NewCopyFromPreviousStage() engine.Rule { return copyFromPreviousStage{} }
 // ID returns the rule identifier.
 func (copyFromPreviousStage) ID() string { return "DL3022" }
 
+// Metadata describes the rule.
+func (copyFromPreviousStage) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "COPY --from should reference a previous FROM alias",
+		Description: "When using multi-stage builds, `COPY --from` must reference an alias or index that refers to a stage defined earlier in the Dockerfile.",
+		Category:    engine.CategoryMultiStage,
+		Tags:        []string{"copy", "stages"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3022"),
+	}
+}
+
 // Check ensures COPY --from references a previously defined stage alias or index.
 func (copyFromPreviousStage) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
@@ -20,6 +20,18 @@ func NewCopyFromSelf() engine.Rule { return copyFromSelf{} }
 // ID returns the rule identifier.
 func (copyFromSelf) ID() string { return "DL3023" }
 
+// Metadata describes the rule.
+func (copyFromSelf) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "COPY --from cannot reference its own stage",
+		Description: "A `COPY --from` flag may not reference the current build stage. Use earlier stages or external images instead.",
+		Category:    engine.CategoryMultiStage,
+		Tags:        []string{"copy", "stages"},
+		Severity:    engine.SeverityError,
+		DocsURL:     docsURL("DL3023"),
+	}
+}
+
 // Check flags COPY --from that references its own FROM alias or index.
 func (copyFromSelf) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
@@ -19,6 +19,18 @@ func NewUniqueStageNames() engine.Rule { return uniqueStageNames{} }
 // ID returns the rule identifier.
 func (uniqueStageNames) ID() string { return "DL3024" }
 
+// Metadata describes the rule.
+func (uniqueStageNames) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "FROM aliases must be unique",
+		Description: "Each stage defined with `FROM ... AS <name>` must use a unique alias name to avoid ambiguity in multi-stage builds.",
+		Category:    engine.CategoryMultiStage,
+		Tags:        []string{"from", "stages"},
+		Severity:    engine.SeverityError,
+		DocsURL:     docsURL("DL3024"),
+	}
+}
+
 // Check verifies no duplicate stage aliases exist.
 func (uniqueStageNames) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
@@ -26,10 +38,7 @@ func (uniqueStageNames) Check(ctx context.Context, d *ir.Document) ([]engine.Fin
 		return findings, nil
 	}
 	aliases := map[string]int{}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "from") {
-			continue
-		}
+	for _, n := range d.Instructions("from") {
 		if name := strings.ToLower(stageAlias(n)); name != "" {
 			if line, ok := aliases[name]; ok {
 				findings = append(findings, engine.Finding{
@@ -19,6 +19,19 @@ func NewJSONNotationCmdEntrypoint() engine.Rule { return jsonNotationCmdEntrypoi
 // ID returns the rule identifier.
 func (jsonNotationCmdEntrypoint) ID() string { return "DL3025" }
 
+// Metadata describes the rule.
+func (jsonNotationCmdEntrypoint) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Use JSON notation for CMD and ENTRYPOINT",
+		Description: "Specify `CMD` and `ENTRYPOINT` arguments using JSON array form to avoid shell interpretation issues.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"cmd", "entrypoint", "json"},
+		Severity:    engine.SeverityWarning,
+		Fixable:     true,
+		DocsURL:     docsURL("DL3025"),
+	}
+}
+
 // Check reports CMD or ENTRYPOINT using shell form.
 func (jsonNotationCmdEntrypoint) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
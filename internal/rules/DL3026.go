@@ -21,6 +21,18 @@ func NewAllowedRegistry(allowed []string) engine.Rule { return &allowedRegistry{
 // ID returns the rule identifier.
 func (allowedRegistry) ID() string { return "DL3026" }
 
+// Metadata describes the rule.
+func (allowedRegistry) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Restrict registries used in FROM images",
+		Description: "Base images should originate from registries explicitly allowed by policy. Any `FROM` instruction using an unapproved registry triggers this rule.",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"from", "registry", "supply-chain"},
+		Severity:    engine.SeverityError,
+		DocsURL:     docsURL("DL3026"),
+	}
+}
+
 // Check validates registry usage in FROM instructions.
 func (r *allowedRegistry) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
@@ -28,10 +40,7 @@ func (r *allowedRegistry) Check(ctx context.Context, d *ir.Document) ([]engine.F
 		return findings, nil
 	}
 	aliases := map[string]struct{}{}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "from") {
-			continue
-		}
+	for _, n := range d.Instructions("from") {
 		image := ""
 		if n.Next != nil {
 			image = n.Next.Value
@@ -19,23 +19,33 @@ func NewNoAptCommand() engine.Rule { return noAptCommand{} }
 // ID returns the rule identifier.
 func (noAptCommand) ID() string { return "DL3027" }
 
+// Metadata describes the rule.
+func (noAptCommand) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Avoid using apt",
+		Description: "The `apt` tool is intended for interactive use. Use `apt-get` or `apt-cache` instead in Dockerfile `RUN` instructions.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"apt"},
+		Severity:    engine.SeverityWarning,
+		Fixable:     true,
+		DocsURL:     docsURL("DL3027"),
+	}
+}
+
 // Check scans RUN instructions for apt usage.
 func (noAptCommand) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		cmds := extractCommands(n)
-		for _, c := range cmds {
-			if c == "apt" {
+	for _, n := range d.Instructions("run") {
+		for _, c := range d.RunCommands(n) {
+			name := strings.ToLower(c.Argv[0])
+			if name == "apt" {
 				findings = append(findings, engine.Finding{
 					RuleID:  "DL3027",
 					Message: "Do not use apt as it is meant to be an end-user tool, use apt-get or apt-cache instead",
-					Line:    n.StartLine,
+					Line:    c.Line,
 				})
 				break
 			}
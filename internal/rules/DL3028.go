@@ -19,23 +19,31 @@ func NewPinGemVersions() engine.Rule { return pinGemVersions{} }
 // ID returns the rule identifier.
 func (pinGemVersions) ID() string { return "DL3028" }
 
+// Metadata describes the rule.
+func (pinGemVersions) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Pin gem versions",
+		Description: "When installing gems, specify versions explicitly. Use `gem install <gem>:<version>` instead of unpinned installs.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"gem", "pinning"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3028"),
+	}
+}
+
 // Check inspects RUN instructions for unpinned gem installs.
 func (pinGemVersions) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		segments := splitRunSegments(n)
-		for _, seg := range segments {
-			if violatesGemPin(seg) {
+	for _, n := range d.Instructions("run") {
+		for _, seg := range runSegments(d, n) {
+			if violatesGemPin(seg.Args) {
 				findings = append(findings, engine.Finding{
 					RuleID:  "DL3028",
 					Message: "Pin versions in gem install. Instead of `gem install <gem>` use `gem install <gem>:<version>`",
-					Line:    n.StartLine,
+					Line:    seg.Line,
 				})
 				break
 			}
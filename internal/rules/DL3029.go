@@ -19,16 +19,25 @@ func NewNoPlatformInFrom() engine.Rule { return noPlatformInFrom{} }
 // ID returns the rule identifier.
 func (noPlatformInFrom) ID() string { return "DL3029" }
 
+// Metadata describes the rule.
+func (noPlatformInFrom) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Do not use --platform flag with FROM",
+		Description: "Avoid specifying a fixed platform in `FROM` instructions. Use build environment variables like `$BUILDPLATFORM` or `$TARGETPLATFORM` instead of hardcoding platforms.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"from", "platform"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3029"),
+	}
+}
+
 // Check warns on --platform usage in FROM instructions.
 func (noPlatformInFrom) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "from") {
-			continue
-		}
+	for _, n := range d.Instructions("from") {
 		for _, f := range n.Flags {
 			if strings.HasPrefix(strings.ToLower(f), "--platform=") {
 				v := strings.TrimPrefix(f, "--platform=")
@@ -20,23 +20,32 @@ func NewRequireYumYes() engine.Rule { return requireYumYes{} }
 // ID returns the rule identifier.
 func (requireYumYes) ID() string { return "DL3030" }
 
+// Metadata describes the rule.
+func (requireYumYes) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Use -y with yum install",
+		Description: "Ensure `yum install` commands include the `-y` or `--assumeyes` option to avoid interactive prompts.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"yum", "non-interactive"},
+		Severity:    engine.SeverityWarning,
+		Fixable:     true,
+		DocsURL:     docsURL("DL3030"),
+	}
+}
+
 // Check inspects RUN instructions for yum install without -y.
 func (requireYumYes) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		segments := splitRunSegments(n)
-		for _, seg := range segments {
-			if isYumInstall(seg) && !hasYumYes(seg) {
+	for _, n := range d.Instructions("run") {
+		for _, seg := range runSegments(d, n) {
+			if isYumInstall(seg.Args) && !hasYumYes(seg.Args) {
 				findings = append(findings, engine.Finding{
 					RuleID:  "DL3030",
 					Message: "Use the -y switch to avoid manual input `yum install -y <package>`",
-					Line:    n.StartLine,
+					Line:    seg.Line,
 				})
 				break
 			}
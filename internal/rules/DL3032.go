@@ -5,7 +5,6 @@ package rules
 
 import (
 	"context"
-	"strings"
 
 	"github.com/asymmetric-effort/docker-lint/internal/engine"
 	"github.com/asymmetric-effort/docker-lint/internal/ir"
@@ -20,17 +19,26 @@ func NewRequireYumClean() engine.Rule { return requireYumClean{} }
 // ID returns the rule identifier.
 func (requireYumClean) ID() string { return "DL3032" }
 
+// Metadata describes the rule.
+func (requireYumClean) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Run `yum clean all`",
+		Description: "After using `yum` to install packages, the cache should be cleared to prevent unnecessary data from persisting in the image layer.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"yum", "image-size"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3032"),
+	}
+}
+
 // Check ensures yum installs are followed by cleanup.
 func (requireYumClean) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		segments := splitRunSegments(n)
+	for _, n := range d.Instructions("run") {
+		segments := splitRunSegments(d, n)
 		if yumCleanMissing(segments) {
 			findings = append(findings, engine.Finding{
 				RuleID:  "DL3032",
@@ -20,26 +20,34 @@ func NewPinYumVersions() engine.Rule { return pinYumVersions{} }
 // ID returns the rule identifier.
 func (pinYumVersions) ID() string { return "DL3033" }
 
+// Metadata describes the rule.
+func (pinYumVersions) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Pin versions in yum install",
+		Description: "`yum install` and `yum module install` commands should specify explicit package or module versions to produce deterministic builds.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"yum", "pinning"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3033"),
+	}
+}
+
 // Check scans RUN instructions for unpinned yum packages.
 func (pinYumVersions) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		segments := splitRunSegments(n)
-		for _, seg := range segments {
-			if len(seg) < 2 || seg[0] != "yum" {
+	for _, n := range d.Instructions("run") {
+		for _, seg := range runSegments(d, n) {
+			if len(seg.Args) < 2 || seg.Args[0] != "yum" {
 				continue
 			}
-			if violatesYumPin(seg) {
+			if violatesYumPin(seg.Args) {
 				findings = append(findings, engine.Finding{
 					RuleID:  "DL3033",
 					Message: "Specify version with `yum install -y <package>-<version>`.",
-					Line:    n.StartLine,
+					Line:    seg.Line,
 				})
 				break
 			}
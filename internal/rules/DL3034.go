@@ -5,7 +5,6 @@ package rules
 
 import (
 	"context"
-	"strings"
 
 	"github.com/asymmetric-effort/docker-lint/internal/engine"
 	"github.com/asymmetric-effort/docker-lint/internal/ir"
@@ -20,23 +19,32 @@ func NewRequireZypperYes() engine.Rule { return requireZypperYes{} }
 // ID returns the rule identifier.
 func (requireZypperYes) ID() string { return "DL3034" }
 
+// Metadata describes the rule.
+func (requireZypperYes) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Use non-interactive zypper",
+		Description: "`zypper` commands should run non-interactively to avoid builds waiting for confirmation.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"zypper", "non-interactive"},
+		Severity:    engine.SeverityWarning,
+		Fixable:     true,
+		DocsURL:     docsURL("DL3034"),
+	}
+}
+
 // Check verifies zypper commands include a non-interactive switch.
 func (requireZypperYes) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		segments := splitRunSegments(n)
-		for _, seg := range segments {
-			if isZypperAction(seg) && !hasZypperYes(seg) {
+	for _, n := range d.Instructions("run") {
+		for _, seg := range runSegments(d, n) {
+			if isZypperAction(seg.Args) && !hasZypperYes(seg.Args) {
 				findings = append(findings, engine.Finding{
 					RuleID:  "DL3034",
 					Message: "Non-interactive switch missing from `zypper` command: `zypper install -y`",
-					Line:    n.StartLine,
+					Line:    seg.Line,
 				})
 				break
 			}
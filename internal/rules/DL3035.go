@@ -5,7 +5,6 @@ package rules
 
 import (
 	"context"
-	"strings"
 
 	"github.com/asymmetric-effort/docker-lint/internal/engine"
 	"github.com/asymmetric-effort/docker-lint/internal/ir"
@@ -20,23 +19,31 @@ func NewForbidZypperDistUpgrade() engine.Rule { return forbidZypperDistUpgrade{}
 // ID returns the rule identifier.
 func (forbidZypperDistUpgrade) ID() string { return "DL3035" }
 
+// Metadata describes the rule.
+func (forbidZypperDistUpgrade) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Avoid `zypper dist-upgrade`",
+		Description: "`zypper dist-upgrade` (or `zypper dup`) can upgrade the base system beyond expected versions and should not be used in Docker builds.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"zypper"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3035"),
+	}
+}
+
 // Check flags zypper dist-upgrade commands.
 func (forbidZypperDistUpgrade) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		segments := splitRunSegments(n)
-		for _, seg := range segments {
-			if isZypperDistUpgrade(seg) {
+	for _, n := range d.Instructions("run") {
+		for _, seg := range runSegments(d, n) {
+			if isZypperDistUpgrade(seg.Args) {
 				findings = append(findings, engine.Finding{
 					RuleID:  "DL3035",
 					Message: "Do not use `zypper dist-upgrade`.",
-					Line:    n.StartLine,
+					Line:    seg.Line,
 				})
 				break
 			}
@@ -5,7 +5,6 @@ package rules
 
 import (
 	"context"
-	"strings"
 
 	"github.com/asymmetric-effort/docker-lint/internal/engine"
 	"github.com/asymmetric-effort/docker-lint/internal/ir"
@@ -20,17 +19,26 @@ func NewRequireZypperClean() engine.Rule { return requireZypperClean{} }
 // ID returns the rule identifier.
 func (requireZypperClean) ID() string { return "DL3036" }
 
+// Metadata describes the rule.
+func (requireZypperClean) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Clean zypper cache",
+		Description: "`zypper` package cache should be cleared after installations to keep images small and avoid stale metadata.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"zypper", "image-size"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3036"),
+	}
+}
+
 // Check ensures zypper installs are followed by cleanup.
 func (requireZypperClean) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		segments := splitRunSegments(n)
+	for _, n := range d.Instructions("run") {
+		segments := splitRunSegments(d, n)
 		if zypperCleanMissing(segments) {
 			findings = append(findings, engine.Finding{
 				RuleID:  "DL3036",
@@ -20,25 +20,33 @@ func NewPinZypperVersions() engine.Rule { return pinZypperVersions{} }
 // ID returns the rule identifier.
 func (pinZypperVersions) ID() string { return "DL3037" }
 
+// Metadata describes the rule.
+func (pinZypperVersions) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Pin versions in zypper install",
+		Description: "`zypper install` commands should pin package versions so the build result is reproducible.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"zypper", "pinning"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3037"),
+	}
+}
+
 // Check scans RUN instructions for unpinned zypper packages.
 func (pinZypperVersions) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		segments := splitRunSegments(n)
-		for _, seg := range segments {
-			if isZypperInstall(seg) {
-				pkgs := collectNonFlag(seg[2:])
+	for _, n := range d.Instructions("run") {
+		for _, seg := range runSegments(d, n) {
+			if isZypperInstall(seg.Args) {
+				pkgs := collectNonFlag(seg.Args[2:])
 				if len(pkgs) > 0 && !allZypperVersionFixed(pkgs) {
 					findings = append(findings, engine.Finding{
 						RuleID:  "DL3037",
 						Message: "Specify version with `zypper install -y <package>=<version>`.",
-						Line:    n.StartLine,
+						Line:    seg.Line,
 					})
 					break
 				}
@@ -20,23 +20,32 @@ func NewRequireDnfYes() engine.Rule { return requireDnfYes{} }
 // ID returns the rule identifier.
 func (requireDnfYes) ID() string { return "DL3038" }
 
+// Metadata describes the rule.
+func (requireDnfYes) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Use -y with dnf install",
+		Description: "`dnf` or `microdnf` install commands should run non-interactively. Missing the `-y` or `--assumeyes` flag can halt builds waiting for user input.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"dnf", "non-interactive"},
+		Severity:    engine.SeverityWarning,
+		Fixable:     true,
+		DocsURL:     docsURL("DL3038"),
+	}
+}
+
 // Check inspects RUN instructions for dnf installs without -y.
 func (requireDnfYes) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		segments := splitRunSegments(n)
-		for _, seg := range segments {
-			if isDnfInstall(seg) && !hasDnfYes(seg) {
+	for _, n := range d.Instructions("run") {
+		for _, seg := range runSegments(d, n) {
+			if isDnfInstall(seg.Args) && !hasDnfYes(seg.Args) {
 				findings = append(findings, engine.Finding{
 					RuleID:  "DL3038",
 					Message: "Use the -y switch to avoid manual input `dnf install -y <package>`",
-					Line:    n.StartLine,
+					Line:    seg.Line,
 				})
 				break
 			}
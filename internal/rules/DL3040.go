@@ -22,17 +22,26 @@ func NewDnfCacheCleanup() engine.Rule { return dnfCacheCleanup{} }
 // ID returns the rule identifier.
 func (dnfCacheCleanup) ID() string { return "DL3040" }
 
+// Metadata describes the rule.
+func (dnfCacheCleanup) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "dnf clean all missing after dnf command",
+		Description: "Report `RUN` instructions that perform a modifying `dnf`/`microdnf` operation without cleaning the package cache in the same instruction. Removing `/var/cache/dnf` metadata keeps layers small and reproducible.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"dnf", "image-size"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3040"),
+	}
+}
+
 // Check scans RUN instructions for dnf/microdnf commands lacking cleanup.
 func (dnfCacheCleanup) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		segments := lowerSegments(splitRunSegments(n))
+	for _, n := range d.Instructions("run") {
+		segments := splitRunSegments(d, n)
 		if needsDnfCleanup(segments) {
 			findings = append(findings, engine.Finding{
 				RuleID:  "DL3040",
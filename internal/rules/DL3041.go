@@ -22,23 +22,31 @@ func NewDnfNoUpgrade() engine.Rule { return dnfNoUpgrade{} }
 // ID returns the rule identifier.
 func (dnfNoUpgrade) ID() string { return "DL3041" }
 
+// Metadata describes the rule.
+func (dnfNoUpgrade) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Avoid dnf upgrade or update in Dockerfiles",
+		Description: "Running `dnf upgrade` or `dnf update` (and their `microdnf` equivalents) updates all packages and can break reproducibility. Use a newer base image or install specific packages with pinned versions instead.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"dnf"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3041"),
+	}
+}
+
 // Check scans RUN instructions for disallowed dnf upgrade or update usage.
 func (dnfNoUpgrade) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		segs := lowerSegments(splitRunSegments(n))
-		for _, seg := range segs {
-			if isDnfUpgrade(seg) {
+	for _, n := range d.Instructions("run") {
+		for _, seg := range runSegments(d, n) {
+			if isDnfUpgrade(seg.Args) {
 				findings = append(findings, engine.Finding{
 					RuleID:  "DL3041",
 					Message: "Avoid dnf upgrade or update; use a newer base image or install specific packages with pinned versions instead.",
-					Line:    n.StartLine,
+					Line:    seg.Line,
 				})
 				break
 			}
@@ -23,6 +23,18 @@ func NewCombinePackageRuns() engine.Rule { return combinePackageRuns{} }
 // ID returns the rule identifier.
 func (combinePackageRuns) ID() string { return "DL3042" }
 
+// Metadata describes the rule.
+func (combinePackageRuns) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Combine consecutive RUN instructions that use the same package manager",
+		Description: "Merge related package manager commands into a single RUN to reduce layers and improve caching.",
+		Category:    engine.CategoryPerformance,
+		Tags:        []string{"run", "layers"},
+		Severity:    engine.SeverityInfo,
+		DocsURL:     docsURL("DL3042"),
+	}
+}
+
 // Check detects consecutive package manager RUN instructions.
 func (combinePackageRuns) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
@@ -38,7 +50,7 @@ func (combinePackageRuns) Check(ctx context.Context, d *ir.Document) ([]engine.F
 			prev = ""
 			continue
 		}
-		pm := runPackageManager(n)
+		pm := runPackageManager(d, n)
 		if pm != "" && pm == prev {
 			findings = append(findings, engine.Finding{
 				RuleID:  "DL3042",
@@ -52,9 +64,9 @@ func (combinePackageRuns) Check(ctx context.Context, d *ir.Document) ([]engine.F
 }
 
 // runPackageManager determines the package manager family used in a RUN instruction.
-func runPackageManager(n *parser.Node) string {
+func runPackageManager(d *ir.Document, n *parser.Node) string {
 	fams := make(map[string]struct{})
-	for _, seg := range splitRunSegments(n) {
+	for _, seg := range splitRunSegments(d, n) {
 		if fam := packageManagerFamily(seg); fam != "" {
 			fams[fam] = struct{}{}
 		}
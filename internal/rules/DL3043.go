@@ -23,6 +23,18 @@ func NewRequireOSVersionTag() engine.Rule { return requireOSVersionTag{} }
 // ID returns the rule identifier.
 func (requireOSVersionTag) ID() string { return "DL3043" }
 
+// Metadata describes the rule.
+func (requireOSVersionTag) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Specify OS version tag for base images",
+		Description: "FROM instructions that reference OS-based images must include an explicit version tag instead of floating tags like `latest` or leaving the tag unset. Pinning the operating system version aids reproducibility and security tracking.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"from", "pinning"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3043"),
+	}
+}
+
 // Check validates that OS images include explicit numeric version tags.
 func (requireOSVersionTag) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
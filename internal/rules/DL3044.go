@@ -22,23 +22,31 @@ func NewDnfVersionPin() engine.Rule { return dnfVersionPin{} }
 // ID returns the rule identifier.
 func (dnfVersionPin) ID() string { return "DL3044" }
 
+// Metadata describes the rule.
+func (dnfVersionPin) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Specify version with dnf/microdnf install",
+		Description: "Pin all packages when using `dnf install` or `microdnf install` by including an explicit version (e.g., `pkg-1.2.3`).",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"dnf", "pinning"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3044"),
+	}
+}
+
 // Check scans RUN instructions for unpinned dnf or microdnf installs.
 func (dnfVersionPin) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		segments := lowerSegments(splitRunSegments(n))
-		for _, seg := range segments {
-			if hasUnpinnedDnfInstall(seg) {
+	for _, n := range d.Instructions("run") {
+		for _, seg := range runSegments(d, n) {
+			if hasUnpinnedDnfInstall(seg.Args) {
 				findings = append(findings, engine.Finding{
 					RuleID:  "DL3044",
 					Message: "Specify version with dnf/microdnf install. Use 'pkg-<version>' format for every installed package.",
-					Line:    n.StartLine,
+					Line:    seg.Line,
 				})
 				break
 			}
@@ -20,6 +20,18 @@ func NewCopyFromExternalDigest() engine.Rule { return copyFromExternalDigest{} }
 // ID returns the rule identifier.
 func (copyFromExternalDigest) ID() string { return "DL3045" }
 
+// Metadata describes the rule.
+func (copyFromExternalDigest) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "COPY --from without digest pinning for external image",
+		Description: "Use a digest when copying from an external image with `COPY --from`. Add `@sha256:<digest>` to the image reference to ensure reproducible builds.",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"copy", "pinning", "supply-chain"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3045"),
+	}
+}
+
 // Check flags external COPY --from references lacking a digest.
 func (copyFromExternalDigest) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
@@ -22,23 +22,31 @@ func NewApkNoUpgrade() engine.Rule { return apkNoUpgrade{} }
 // ID returns the rule identifier.
 func (apkNoUpgrade) ID() string { return "DL3046" }
 
+// Metadata describes the rule.
+func (apkNoUpgrade) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Avoid apk upgrade in Dockerfiles",
+		Description: "Avoid `apk upgrade` in Dockerfiles. Upgrade the base image or install specific pinned packages instead.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"apk"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3046"),
+	}
+}
+
 // Check scans RUN instructions for `apk upgrade` invocations.
 func (apkNoUpgrade) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		segs := lowerSegments(splitRunSegments(n))
-		for _, seg := range segs {
-			if isApkUpgrade(seg) {
+	for _, n := range d.Instructions("run") {
+		for _, seg := range runSegments(d, n) {
+			if isApkUpgrade(seg.Args) {
 				findings = append(findings, engine.Finding{
 					RuleID:  "DL3046",
 					Message: "Avoid apk upgrade in Dockerfiles. Upgrade the base image or install specific pinned packages instead.",
-					Line:    n.StartLine,
+					Line:    seg.Line,
 				})
 				break
 			}
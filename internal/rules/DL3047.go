@@ -22,17 +22,26 @@ func NewApkCacheCleanup() engine.Rule { return apkCacheCleanup{} }
 // ID returns the rule identifier.
 func (apkCacheCleanup) ID() string { return "DL3047" }
 
+// Metadata describes the rule.
+func (apkCacheCleanup) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Clean apk cache after installing packages",
+		Description: "Clean APK cache after installing packages. Use `apk add --no-cache` or remove `/var/cache/apk/*` in the same RUN instruction.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"apk", "image-size"},
+		Severity:    engine.SeverityInfo,
+		DocsURL:     docsURL("DL3047"),
+	}
+}
+
 // Check verifies apk add usage includes --no-cache or cache removal.
 func (apkCacheCleanup) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		segments := lowerSegments(splitRunSegments(n))
+	for _, n := range d.Instructions("run") {
+		segments := splitRunSegments(d, n)
 		if needsApkCacheCleanup(segments) {
 			findings = append(findings, engine.Finding{
 				RuleID:  "DL3047",
@@ -27,6 +27,18 @@ func NewLabelKeyValid() engine.Rule { return labelKeyValid{} }
 // ID returns the rule identifier.
 func (labelKeyValid) ID() string { return "DL3048" }
 
+// Metadata describes the rule.
+func (labelKeyValid) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Invalid Label Key",
+		Description: "Label keys must use lower-case a–z, 0–9, '.' and '-' only, avoid reserved namespaces, and cannot have leading/trailing or repeated separators.",
+		Category:    engine.CategoryLabels,
+		Tags:        []string{"label"},
+		Severity:    engine.SeverityStyle,
+		DocsURL:     docsURL("DL3048"),
+	}
+}
+
 // Check validates label keys for reserved namespaces and allowed characters.
 func (labelKeyValid) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
@@ -51,7 +63,7 @@ func (labelKeyValid) Check(ctx context.Context, d *ir.Document) ([]engine.Findin
 // validateLabelNode reports invalid label keys from the given LABEL node.
 func validateLabelNode(ln *parser.Node, lineNode *parser.Node) []engine.Finding {
 	var findings []engine.Finding
-	for _, p := range collectLabelPairs(ln) {
+	for _, p := range ir.LabelPairs(ln) {
 		if invalidLabelKey(p.Key) {
 			findings = append(findings, engine.Finding{
 				RuleID:  "DL3048",
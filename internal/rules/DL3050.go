@@ -7,7 +7,8 @@ package rules
 
 import (
 	"context"
-	"strings"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
 
 	"github.com/asymmetric-effort/docker-lint/internal/engine"
 	"github.com/asymmetric-effort/docker-lint/internal/ir"
@@ -27,21 +28,30 @@ func NewSuperfluousLabels(schema LabelSchema, strict bool) engine.Rule {
 // ID returns the rule identifier.
 func (superfluousLabels) ID() string { return "DL3050" }
 
+// Metadata describes the rule.
+func (superfluousLabels) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Superfluous label(s) present",
+		Description: "When a label schema is configured and strict mode is enabled, report `LABEL` keys that are not part of the schema. Restricting labels to a known set prevents typos and unauthorized metadata from entering images.",
+		Category:    engine.CategoryLabels,
+		Tags:        []string{"label", "schema"},
+		Severity:    engine.SeverityInfo,
+		OptIn:       true,
+		DocsURL:     docsURL("DL3050"),
+	}
+}
+
 // Check reports labels not present in the schema when strict mode is enabled.
 func (r *superfluousLabels) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if !r.strict || d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "label") {
-			continue
-		}
-		for _, p := range collectLabelPairs(n) {
-			if !inSchema(r.schema, p.Key) {
-				findings = append(findings, engine.Finding{RuleID: "DL3050", Message: "Superfluous label(s) present.", Line: n.StartLine})
-				break
-			}
+	var flagged *parser.Node
+	for _, p := range d.Labels() {
+		if p.Node != flagged && !inSchema(r.schema, p.Key) {
+			findings = append(findings, engine.Finding{RuleID: "DL3050", Message: "Superfluous label(s) present.", Line: p.Node.StartLine})
+			flagged = p.Node
 		}
 	}
 	return findings, nil
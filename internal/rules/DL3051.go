@@ -22,6 +22,18 @@ func NewLabelNotEmpty(schema LabelSchema) engine.Rule { return &labelNotEmpty{sc
 // ID returns the rule identifier.
 func (labelNotEmpty) ID() string { return "DL3051" }
 
+// Metadata describes the rule.
+func (labelNotEmpty) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Label value is empty",
+		Description: "Labels defined in the schema must have a non-empty value. Hadolint only evaluates this rule when a label schema or `--require-label` is provided. If a required key appears with an empty value (after trimming whitespace), a finding is reported.",
+		Category:    engine.CategoryLabels,
+		Tags:        []string{"label", "schema"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3051"),
+	}
+}
+
 // Check reports schema-defined labels that have empty values.
 func (r *labelNotEmpty) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
@@ -35,13 +47,8 @@ func (r *labelNotEmpty) Check(ctx context.Context, d *ir.Document) ([]engine.Fin
 	}
 	labels := map[string]labelInfo{}
 
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "label") {
-			continue
-		}
-		for _, p := range collectLabelPairs(n) {
-			labels[p.Key] = labelInfo{val: strings.TrimSpace(p.Value), line: n.StartLine}
-		}
+	for _, p := range d.Labels() {
+		labels[p.Key] = labelInfo{val: strings.TrimSpace(p.Value), line: p.Node.StartLine}
 	}
 
 	for k, info := range labels {
@@ -8,7 +8,6 @@ package rules
 import (
 	"context"
 	"net/url"
-	"strings"
 
 	"github.com/asymmetric-effort/docker-lint/internal/engine"
 	"github.com/asymmetric-effort/docker-lint/internal/ir"
@@ -23,22 +22,29 @@ func NewLabelURLValid(schema LabelSchema) engine.Rule { return &labelURLValid{sc
 // ID returns the rule identifier.
 func (labelURLValid) ID() string { return "DL3052" }
 
+// Metadata describes the rule.
+func (labelURLValid) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Label is not a valid URL",
+		Description: "Labels expected to contain URLs must use valid URL syntax with scheme and host.",
+		Category:    engine.CategoryLabels,
+		Tags:        []string{"label", "schema"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3052"),
+	}
+}
+
 // Check validates URL labels against RFC 3986.
 func (r *labelURLValid) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "label") {
-			continue
-		}
-		for _, p := range collectLabelPairs(n) {
-			if r.schema[p.Key] == LabelTypeURL {
-				u, err := url.Parse(p.Value)
-				if err != nil || u.Scheme == "" || u.Host == "" {
-					findings = append(findings, engine.Finding{RuleID: "DL3052", Message: "Label `" + p.Key + "` is not a valid URL.", Line: n.StartLine})
-				}
+	for _, p := range d.Labels() {
+		if r.schema[p.Key] == LabelTypeURL {
+			u, err := url.Parse(p.Value)
+			if err != nil || u.Scheme == "" || u.Host == "" {
+				findings = append(findings, engine.Finding{RuleID: "DL3052", Message: "Label `" + p.Key + "` is not a valid URL.", Line: p.Node.StartLine})
 			}
 		}
 	}
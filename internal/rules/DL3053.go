@@ -7,7 +7,6 @@ package rules
 
 import (
 	"context"
-	"strings"
 	"time"
 
 	"github.com/asymmetric-effort/docker-lint/internal/engine"
@@ -23,21 +22,28 @@ func NewLabelTimeRFC3339(schema LabelSchema) engine.Rule { return &labelTimeRFC3
 // ID returns the rule identifier.
 func (labelTimeRFC3339) ID() string { return "DL3053" }
 
+// Metadata describes the rule.
+func (labelTimeRFC3339) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Label does not conform to RFC3339",
+		Description: "Labels designated as timestamps must be formatted according to RFC3339.",
+		Category:    engine.CategoryLabels,
+		Tags:        []string{"label", "schema"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3053"),
+	}
+}
+
 // Check validates time labels.
 func (r *labelTimeRFC3339) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "label") {
-			continue
-		}
-		for _, p := range collectLabelPairs(n) {
-			if r.schema[p.Key] == LabelTypeRFC3339 {
-				if _, err := time.Parse(time.RFC3339, p.Value); err != nil {
-					findings = append(findings, engine.Finding{RuleID: "DL3053", Message: "Label `" + p.Key + "` is not a valid time format - must conform to RFC3339.", Line: n.StartLine})
-				}
+	for _, p := range d.Labels() {
+		if r.schema[p.Key] == LabelTypeRFC3339 {
+			if _, err := time.Parse(time.RFC3339, p.Value); err != nil {
+				findings = append(findings, engine.Finding{RuleID: "DL3053", Message: "Label `" + p.Key + "` is not a valid time format - must conform to RFC3339.", Line: p.Node.StartLine})
 			}
 		}
 	}
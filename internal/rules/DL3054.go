@@ -8,7 +8,6 @@ package rules
 import (
 	"context"
 	"regexp"
-	"strings"
 
 	"github.com/asymmetric-effort/docker-lint/internal/engine"
 	"github.com/asymmetric-effort/docker-lint/internal/ir"
@@ -25,21 +24,28 @@ func NewLabelSPDXValid(schema LabelSchema) engine.Rule { return &labelSPDXValid{
 // ID returns the rule identifier.
 func (labelSPDXValid) ID() string { return "DL3054" }
 
+// Metadata describes the rule.
+func (labelSPDXValid) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Label is not a valid SPDX identifier",
+		Description: "License labels must match the SPDX identifier pattern.",
+		Category:    engine.CategoryLabels,
+		Tags:        []string{"label", "schema", "license"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3054"),
+	}
+}
+
 // Check validates SPDX label values.
 func (r *labelSPDXValid) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "label") {
-			continue
-		}
-		for _, p := range collectLabelPairs(n) {
-			if r.schema[p.Key] == LabelTypeSPDX {
-				if !spdxPattern.MatchString(p.Value) {
-					findings = append(findings, engine.Finding{RuleID: "DL3054", Message: "Label `" + p.Key + "` is not a valid SPDX identifier.", Line: n.StartLine})
-				}
+	for _, p := range d.Labels() {
+		if r.schema[p.Key] == LabelTypeSPDX {
+			if !spdxPattern.MatchString(p.Value) {
+				findings = append(findings, engine.Finding{RuleID: "DL3054", Message: "Label `" + p.Key + "` is not a valid SPDX identifier.", Line: p.Node.StartLine})
 			}
 		}
 	}
@@ -31,6 +31,19 @@ func NewStageDigestPinned(stages []string) engine.Rule {
 // ID returns the rule identifier.
 func (stageDigestPinned) ID() string { return "DL3055" }
 
+// Metadata describes the rule.
+func (stageDigestPinned) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Stage image is not pinned by digest",
+		Description: "Ensure configured build stages use images pinned by digest using `@sha256:<digest>` to guarantee reproducible builds.",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"from", "pinning", "supply-chain"},
+		Severity:    engine.SeverityWarning,
+		OptIn:       true,
+		DocsURL:     docsURL("DL3055"),
+	}
+}
+
 // Check verifies required stages use digest-pinned images.
 func (r *stageDigestPinned) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
@@ -8,7 +8,6 @@ package rules
 import (
 	"context"
 	"regexp"
-	"strings"
 
 	"github.com/asymmetric-effort/docker-lint/internal/engine"
 	"github.com/asymmetric-effort/docker-lint/internal/ir"
@@ -25,21 +24,28 @@ func NewLabelSemVerValid(schema LabelSchema) engine.Rule { return &labelSemVerVa
 // ID returns the rule identifier.
 func (labelSemVerValid) ID() string { return "DL3056" }
 
+// Metadata describes the rule.
+func (labelSemVerValid) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Label does not conform to semantic versioning",
+		Description: "Labels representing versions must follow the semantic versioning specification.",
+		Category:    engine.CategoryLabels,
+		Tags:        []string{"label", "schema"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3056"),
+	}
+}
+
 // Check validates semantic version labels.
 func (r *labelSemVerValid) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "label") {
-			continue
-		}
-		for _, p := range collectLabelPairs(n) {
-			if r.schema[p.Key] == LabelTypeSemVer {
-				if !semverPattern.MatchString(p.Value) {
-					findings = append(findings, engine.Finding{RuleID: "DL3056", Message: "Label `" + p.Key + "` does not conform to semantic versioning.", Line: n.StartLine})
-				}
+	for _, p := range d.Labels() {
+		if r.schema[p.Key] == LabelTypeSemVer {
+			if !semverPattern.MatchString(p.Value) {
+				findings = append(findings, engine.Finding{RuleID: "DL3056", Message: "Label `" + p.Key + "` does not conform to semantic versioning.", Line: p.Node.StartLine})
 			}
 		}
 	}
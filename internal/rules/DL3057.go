@@ -7,13 +7,12 @@ package rules
 
 import (
 	"context"
-	"strings"
 
 	"github.com/asymmetric-effort/docker-lint/internal/engine"
 	"github.com/asymmetric-effort/docker-lint/internal/ir"
 )
 
-// healthcheckExists reports stages missing a HEALTHCHECK instruction.
+// healthcheckExists reports a target stage missing a HEALTHCHECK instruction.
 type healthcheckExists struct{}
 
 // NewHealthcheckExists constructs the rule.
@@ -22,75 +21,36 @@ func NewHealthcheckExists() engine.Rule { return healthcheckExists{} }
 // ID returns the rule identifier.
 func (healthcheckExists) ID() string { return "DL3057" }
 
-// Check verifies that each stage or its base image defines a HEALTHCHECK.
+// Metadata describes the rule.
+func (healthcheckExists) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "`HEALTHCHECK` instruction missing",
+		Description: "The target stage (the final stage unless `--target` selects another) should define a `HEALTHCHECK` or inherit from a stage that does, and must not disable it with `HEALTHCHECK NONE`. Builder stages that do not end up in the image are not checked.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"healthcheck"},
+		Severity:    engine.SeverityInfo,
+		OptIn:       true,
+		DocsURL:     docsURL("DL3057"),
+	}
+}
+
+// Check verifies that the HEALTHCHECK in effect for the target stage, defined
+// by the stage or a stage it inherits from, exists and is not NONE.
 func (healthcheckExists) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	type stage struct {
-		line     int
-		parent   int
-		hasCheck bool
-	}
-	var stages []*stage
-	nameMap := make(map[string]int)
-	current := -1
-	for _, n := range d.AST.Children {
-		switch strings.ToLower(n.Value) {
-		case "from":
-			base := ""
-			alias := ""
-			if n.Next != nil {
-				base = n.Next.Value
-				for tok := n.Next.Next; tok != nil; tok = tok.Next {
-					if strings.EqualFold(tok.Value, "as") && tok.Next != nil {
-						alias = tok.Next.Value
-						break
-					}
-				}
-			}
-			parent := -1
-			if idx, ok := nameMap[base]; ok {
-				parent = idx
-			}
-			st := &stage{line: n.StartLine, parent: parent}
-			stages = append(stages, st)
-			name := alias
-			if name == "" {
-				name = base
-			}
-			nameMap[name] = len(stages) - 1
-			current = len(stages) - 1
-		case "healthcheck":
-			if current >= 0 {
-				stages[current].hasCheck = true
-			}
-		}
-	}
-	cache := make(map[int]bool)
-	var hasHC func(int) bool
-	hasHC = func(id int) bool {
-		if v, ok := cache[id]; ok {
-			return v
-		}
-		st := stages[id]
-		if st.hasCheck {
-			cache[id] = true
-			return true
-		}
-		if st.parent == -1 {
-			cache[id] = false
-			return false
-		}
-		v := hasHC(st.parent)
-		cache[id] = v
-		return v
+	target := d.TargetStage()
+	if target == nil {
+		return findings, nil
 	}
-	for id, st := range stages {
-		if !hasHC(id) {
-			findings = append(findings, engine.Finding{RuleID: "DL3057", Message: "`HEALTHCHECK` instruction missing.", Line: st.line})
+	if n := stageHealthcheck(d, target); n != nil {
+		if isHealthcheckNone(n) {
+			findings = append(findings, engine.Finding{RuleID: "DL3057", Message: "`HEALTHCHECK NONE` disables the health check of the image.", Line: n.StartLine})
 		}
+		return findings, nil
 	}
+	findings = append(findings, engine.Finding{RuleID: "DL3057", Message: "`HEALTHCHECK` instruction missing.", Line: target.Node.StartLine})
 	return findings, nil
 }
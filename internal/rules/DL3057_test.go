@@ -78,9 +78,48 @@ func TestHealthcheckExistsInherited(t *testing.T) {
 	}
 }
 
+func TestHealthcheckExistsIgnoresBuilderStages(t *testing.T) {
+	src := "FROM golang:1.22 AS build\nRUN go build ./...\nFROM scratch\nCOPY --from=build /app /app\nHEALTHCHECK CMD [\"/app\", \"-health\"]\n"
+	res, err := parser.Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	doc, err := ir.BuildDocument("Dockerfile", res.AST)
+	if err != nil {
+		t.Fatalf("build document: %v", err)
+	}
+	r := NewHealthcheckExists()
+	findings, err := r.Check(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("check failed: %v", err)
+	}
+	if len(findings) != 0 {
+		t.Fatalf("expected no findings, got %d", len(findings))
+	}
+}
+
 func TestHealthcheckExistsNilDocument(t *testing.T) {
 	r := NewHealthcheckExists()
 	if f, err := r.Check(context.Background(), nil); err != nil || len(f) != 0 {
 		t.Fatalf("expected no findings on nil doc: %v %v", f, err)
 	}
 }
+
+// TestHealthcheckExistsNone reports a HEALTHCHECK NONE in effect for the target stage.
+func TestHealthcheckExistsNone(t *testing.T) {
+	cases := map[string]int{
+		"FROM scratch\nHEALTHCHECK NONE\n":                                                   1,
+		"FROM scratch AS base\nHEALTHCHECK CMD [\"/hc\"]\nFROM base\nHEALTHCHECK NONE\n":     1,
+		"FROM scratch AS base\nHEALTHCHECK NONE\nFROM base\nHEALTHCHECK CMD [\"/hc\"]\n":     0,
+		"FROM scratch AS build\nHEALTHCHECK NONE\nFROM scratch\nHEALTHCHECK CMD [\"/hc\"]\n": 0,
+	}
+	for src, want := range cases {
+		findings := checkRule(t, NewHealthcheckExists(), src)
+		if len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %#v", src, want, findings)
+		}
+		if want == 1 && findings[0].Line != strings.Count(src, "\n") {
+			t.Fatalf("%q: expected the finding on the HEALTHCHECK NONE line, got %d", src, findings[0].Line)
+		}
+	}
+}
@@ -8,7 +8,6 @@ package rules
 import (
 	"context"
 	"net/mail"
-	"strings"
 
 	"github.com/asymmetric-effort/docker-lint/internal/engine"
 	"github.com/asymmetric-effort/docker-lint/internal/ir"
@@ -23,21 +22,28 @@ func NewLabelEmailValid(schema LabelSchema) engine.Rule { return &labelEmailVali
 // ID returns the rule identifier.
 func (labelEmailValid) ID() string { return "DL3058" }
 
+// Metadata describes the rule.
+func (labelEmailValid) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Label is not a valid email address",
+		Description: "Labels defined as email addresses must conform to RFC5322 formatting.",
+		Category:    engine.CategoryLabels,
+		Tags:        []string{"label", "schema"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3058"),
+	}
+}
+
 // Check validates email label values.
 func (r *labelEmailValid) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "label") {
-			continue
-		}
-		for _, p := range collectLabelPairs(n) {
-			if r.schema[p.Key] == LabelTypeEmail {
-				if _, err := mail.ParseAddress(p.Value); err != nil {
-					findings = append(findings, engine.Finding{RuleID: "DL3058", Message: "Label `" + p.Key + "` is not a valid email format - must conform to RFC5322.", Line: n.StartLine})
-				}
+	for _, p := range d.Labels() {
+		if r.schema[p.Key] == LabelTypeEmail {
+			if _, err := mail.ParseAddress(p.Value); err != nil {
+				findings = append(findings, engine.Finding{RuleID: "DL3058", Message: "Label `" + p.Key + "` is not a valid email format - must conform to RFC5322.", Line: p.Node.StartLine})
 			}
 		}
 	}
@@ -16,11 +16,10 @@ import (
 )
 
 // consecutiveRun detects multiple consecutive RUN instructions with few commands.
-type consecutiveRun struct {
-	prevFlags string
-	prevCount int
-	seen      bool
-}
+//
+// The rule keeps no state between documents so that it may check several
+// documents concurrently.
+type consecutiveRun struct{}
 
 // NewConsecutiveRun constructs the rule.
 func NewConsecutiveRun() engine.Rule { return &consecutiveRun{} }
@@ -28,29 +27,45 @@ func NewConsecutiveRun() engine.Rule { return &consecutiveRun{} }
 // ID returns the rule identifier.
 func (consecutiveRun) ID() string { return "DL3059" }
 
+// Metadata describes the rule.
+func (consecutiveRun) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Multiple consecutive `RUN` instructions",
+		Description: "Use a single `RUN` with command chaining instead of many simple consecutive `RUN` layers.",
+		Category:    engine.CategoryPerformance,
+		Tags:        []string{"run", "layers"},
+		Severity:    engine.SeverityInfo,
+		DocsURL:     docsURL("DL3059"),
+	}
+}
+
 // Check flags consecutive simple RUN instructions for consolidation.
-func (r *consecutiveRun) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+func (*consecutiveRun) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	reset := func() { r.seen = false }
+	var (
+		prevFlags string
+		prevCount int
+		seen      bool
+	)
 	for _, n := range d.AST.Children {
 		if !strings.EqualFold(n.Value, "run") {
 			if strings.EqualFold(n.Value, "#") {
 				continue
 			}
-			reset()
+			seen = false
 			continue
 		}
-		count := countRunCommands(n)
+		count := countRunCommands(d, n)
 		flags := canonicalFlags(n.Flags)
-		if r.seen && r.prevFlags == flags && r.prevCount <= 2 && count <= 2 {
+		if seen && prevFlags == flags && prevCount <= 2 && count <= 2 {
 			findings = append(findings, engine.Finding{RuleID: "DL3059", Message: "Multiple consecutive `RUN` instructions. Consider consolidation.", Line: n.StartLine})
 		}
-		r.prevFlags = flags
-		r.prevCount = count
-		r.seen = true
+		prevFlags = flags
+		prevCount = count
+		seen = true
 	}
 	return findings, nil
 }
@@ -63,7 +78,7 @@ func canonicalFlags(flags []string) string {
 }
 
 // countRunCommands returns the number of commands in a RUN instruction.
-func countRunCommands(n *parser.Node) int {
-	segs := splitRunSegments(n)
+func countRunCommands(d *ir.Document, n *parser.Node) int {
+	segs := splitRunSegments(d, n)
 	return len(segs)
 }
@@ -9,8 +9,6 @@ import (
 	"context"
 	"strings"
 
-	"github.com/google/shlex"
-
 	"github.com/asymmetric-effort/docker-lint/internal/engine"
 	"github.com/asymmetric-effort/docker-lint/internal/ir"
 )
@@ -24,30 +22,31 @@ func NewYarnCacheClean() engine.Rule { return yarnCacheClean{} }
 // ID returns the rule identifier.
 func (yarnCacheClean) ID() string { return "DL3060" }
 
+// Metadata describes the rule.
+func (yarnCacheClean) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "`yarn cache clean` missing after `yarn install`",
+		Description: "Run commands that execute `yarn install` should also clean the yarn cache in the same layer, unless a BuildKit cache mount is used.",
+		Category:    engine.CategoryPackageManagement,
+		Tags:        []string{"yarn", "image-size"},
+		Severity:    engine.SeverityInfo,
+		DocsURL:     docsURL("DL3060"),
+	}
+}
+
 // Check warns when `yarn install` is used without subsequent `yarn cache clean`.
 func (yarnCacheClean) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
+	for _, n := range d.Instructions("run") {
 		if hasCacheMount(n.Flags) {
 			continue
 		}
-		if n.Next == nil {
-			continue
-		}
-		tokens, err := shlex.Split(n.Next.Value)
-		if err != nil {
-			continue
-		}
-		segments := splitByConnectors(tokens)
 		install := false
 		clean := false
-		for _, seg := range segments {
+		for _, seg := range splitRunSegments(d, n) {
 			if isYarnInstall(seg) {
 				install = true
 			}
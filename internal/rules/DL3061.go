@@ -19,6 +19,18 @@ func NewStartWithFromOrArg() engine.Rule { return startWithFromOrArg{} }
 // ID returns the rule identifier.
 func (startWithFromOrArg) ID() string { return "DL3061" }
 
+// Metadata describes the rule.
+func (startWithFromOrArg) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Dockerfile must start with FROM or ARG",
+		Description: "Invalid instruction order. Dockerfile must begin with `FROM`, `ARG` or comment.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"from", "arg"},
+		Severity:    engine.SeverityError,
+		DocsURL:     docsURL("DL3061"),
+	}
+}
+
 // Check verifies that the first instruction is FROM or ARG.
 func (startWithFromOrArg) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
@@ -0,0 +1,58 @@
+package rules
+
+/*
+ * file: internal/rules/DL3062.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// unreachableStage reports stages the build target never depends on.
+type unreachableStage struct{}
+
+// NewUnreachableStage constructs the rule.
+func NewUnreachableStage() engine.Rule { return unreachableStage{} }
+
+// ID returns the rule identifier.
+func (unreachableStage) ID() string { return "DL3062" }
+
+// Metadata describes the rule.
+func (unreachableStage) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Stage is not used by the target stage",
+		Description: "A stage that the build target never reaches through `FROM <stage>`, `COPY --from=<stage>` or `RUN --mount=from=<stage>` is skipped by BuildKit. Remove the dead stage or build it explicitly with `--target`. The final stage is the target unless `docker-lint --target <stage>` selects another one.",
+		Category:    engine.CategoryMultiStage,
+		Tags:        []string{"stages", "dead-code"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3062"),
+	}
+}
+
+// Check flags stages that are not reachable from the target stage via FROM,
+// COPY --from or RUN --mount=from= references.
+func (unreachableStage) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	for _, st := range d.Stages {
+		if st.Reachable {
+			continue
+		}
+		name := st.Name
+		if name == "" {
+			name = st.From
+		}
+		findings = append(findings, engine.Finding{
+			RuleID:  "DL3062",
+			Message: "Stage `" + name + "` is not used by the target stage and will not be built",
+			Line:    st.Node.StartLine,
+		})
+	}
+	return findings, nil
+}
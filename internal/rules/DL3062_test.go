@@ -0,0 +1,90 @@
+// file: internal/rules/DL3062_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// TestIntegrationUnreachableStageID validates rule identity.
+func TestIntegrationUnreachableStageID(t *testing.T) {
+	if NewUnreachableStage().ID() != "DL3062" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationUnreachableStageViolation detects stages never used by the target.
+func TestIntegrationUnreachableStageViolation(t *testing.T) {
+	src := "FROM alpine:3.19 AS unused\nRUN echo hi\nFROM golang:1.22 AS build\nRUN go build\nFROM alpine:3.19\nCOPY --from=build /app /app\n"
+	res, err := parser.Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	doc, err := ir.BuildDocument("Dockerfile", res.AST)
+	if err != nil {
+		t.Fatalf("build document: %v", err)
+	}
+	findings, err := NewUnreachableStage().Check(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("check failed: %v", err)
+	}
+	if len(findings) != 1 || findings[0].Line != 1 {
+		t.Fatalf("expected finding on line 1, got %#v", findings)
+	}
+}
+
+// TestIntegrationUnreachableStageMount treats RUN --mount=from= as a dependency.
+func TestIntegrationUnreachableStageMount(t *testing.T) {
+	src := "FROM alpine:3.19 AS deps\nRUN echo hi\nFROM alpine:3.19\nRUN --mount=type=bind,from=deps,target=/deps ls /deps\n"
+	res, err := parser.Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	doc, err := ir.BuildDocument("Dockerfile", res.AST)
+	if err != nil {
+		t.Fatalf("build document: %v", err)
+	}
+	findings, err := NewUnreachableStage().Check(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("check failed: %v", err)
+	}
+	if len(findings) != 0 {
+		t.Fatalf("expected no findings, got %#v", findings)
+	}
+}
+
+// TestIntegrationUnreachableStageTarget flags stages after an explicit target.
+func TestIntegrationUnreachableStageTarget(t *testing.T) {
+	src := "FROM alpine:3.19 AS base\nFROM base AS test\nFROM base AS release\n"
+	res, err := parser.Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	doc, err := ir.BuildDocument("Dockerfile", res.AST)
+	if err != nil {
+		t.Fatalf("build document: %v", err)
+	}
+	if err := doc.SetTarget("test"); err != nil {
+		t.Fatalf("set target: %v", err)
+	}
+	findings, err := NewUnreachableStage().Check(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("check failed: %v", err)
+	}
+	if len(findings) != 1 || findings[0].Line != 3 {
+		t.Fatalf("expected finding on line 3, got %#v", findings)
+	}
+}
+
+// TestIntegrationUnreachableStageNilDocument ensures graceful handling of nil input.
+func TestIntegrationUnreachableStageNilDocument(t *testing.T) {
+	if f, err := NewUnreachableStage().Check(context.Background(), nil); err != nil || len(f) != 0 {
+		t.Fatalf("expected no findings on nil doc: %v %v", f, err)
+	}
+}
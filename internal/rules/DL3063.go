@@ -0,0 +1,84 @@
+package rules
+
+/*
+ * file: internal/rules/DL3063.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// shellAssignment matches NAME=value assignments, optionally exported, in shell source.
+var shellAssignment = regexp.MustCompile(`(?:^|[\s;&|(])(?:export\s+)?([A-Za-z_][A-Za-z0-9_]*)=("[^"]*"|'[^']*'|[^\s;&|)]*)`)
+
+// sensitiveVariable flags credentials passed through ENV, ARG or RUN variables.
+type sensitiveVariable struct{}
+
+// NewSensitiveVariable constructs the rule.
+func NewSensitiveVariable() engine.Rule { return sensitiveVariable{} }
+
+// ID returns the rule identifier.
+func (sensitiveVariable) ID() string { return "DL3063" }
+
+// Metadata describes the rule.
+func (sensitiveVariable) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Do not pass secrets in variables",
+		Description: "`ENV` and `ARG` values named like credentials (`*_PASSWORD`, `*_TOKEN`, `*_SECRET*`, `*API_KEY` ...) are recorded in the image configuration or build history, as are literal credentials assigned in `RUN`. Mount secrets with `RUN --mount=type=secret` instead.",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"secrets", "env", "arg", "run"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3063"),
+	}
+}
+
+// Check reports sensitive ENV and ARG names, and sensitive names assigned a
+// literal value in RUN.
+func (sensitiveVariable) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	report := func(kw, name string, line int) {
+		findings = append(findings, engine.Finding{
+			RuleID:  "DL3063",
+			Message: fmt.Sprintf("%s sets sensitive variable %s, which is stored in the image; %s", kw, name, secretAdvice),
+			Line:    line,
+		})
+	}
+	for _, n := range d.AST.Children {
+		switch strings.ToLower(n.Value) {
+		case "arg":
+			for tok := n.Next; tok != nil; tok = tok.Next {
+				if name, _, _ := strings.Cut(tok.Value, "="); sensitiveName(name) {
+					report("ARG", name, n.StartLine)
+				}
+			}
+		case "env":
+			// The parser emits each pair as key, value and separator nodes.
+			for tok := n.Next; tok != nil && tok.Next != nil; {
+				if sensitiveName(tok.Value) && strings.Trim(tok.Next.Value, `"'`) != "" {
+					report("ENV", tok.Value, n.StartLine)
+				}
+				if tok = tok.Next.Next; tok != nil {
+					tok = tok.Next
+				}
+			}
+		case "run":
+			for _, m := range shellAssignment.FindAllStringSubmatch(instructionArgs(n), -1) {
+				val := strings.Trim(m[2], `"'`)
+				if sensitiveName(m[1]) && val != "" && !strings.HasPrefix(val, "$") {
+					report("RUN", m[1], n.StartLine)
+				}
+			}
+		}
+	}
+	return findings, nil
+}
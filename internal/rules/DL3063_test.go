@@ -0,0 +1,56 @@
+// file: internal/rules/DL3063_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestIntegrationSensitiveVariableID validates rule identity.
+func TestIntegrationSensitiveVariableID(t *testing.T) {
+	if NewSensitiveVariable().ID() != "DL3063" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationSensitiveVariableViolation detects credentials passed in variables.
+func TestIntegrationSensitiveVariableViolation(t *testing.T) {
+	src := "FROM alpine:3.19\n" +
+		"ARG GITHUB_TOKEN\n" +
+		"ENV AWS_SECRET_ACCESS_KEY=abc DB_HOST=db\n" +
+		"RUN export NPM_TOKEN=s3cr3t && npm ci\n" +
+		"RUN DB_PASSWORD='hunter2' ./migrate\n"
+	findings := checkRule(t, NewSensitiveVariable(), src)
+	want := []int{2, 3, 4, 5}
+	if len(findings) != len(want) {
+		t.Fatalf("expected %d findings, got %#v", len(want), findings)
+	}
+	for i, f := range findings {
+		if f.Line != want[i] {
+			t.Fatalf("finding %d: expected line %d, got %d", i, want[i], f.Line)
+		}
+		if !strings.Contains(f.Message, "--mount=type=secret") {
+			t.Fatalf("message lacks advice: %q", f.Message)
+		}
+	}
+	for _, secret := range []string{"abc", "s3cr3t", "hunter2"} {
+		for _, f := range findings {
+			if strings.Contains(f.Message, secret) {
+				t.Fatalf("message reveals value: %q", f.Message)
+			}
+		}
+	}
+}
+
+// TestIntegrationSensitiveVariableClean ignores references, files and unrelated names.
+func TestIntegrationSensitiveVariableClean(t *testing.T) {
+	src := "FROM alpine:3.19\n" +
+		"ARG VERSION=1.0\n" +
+		"ENV PASSWORD_FILE=/run/secrets/db PUBLIC_KEY=abc PWD_HINT=x EMPTY_TOKEN=\"\"\n" +
+		"RUN --mount=type=secret,id=token,env=GITHUB_TOKEN TOKEN=$(cat /run/secrets/t) make\n" +
+		"RUN API_KEY=$API_KEY ./deploy\n"
+	if findings := checkRule(t, NewSensitiveVariable(), src); len(findings) != 0 {
+		t.Fatalf("expected no findings, got %#v", findings)
+	}
+}
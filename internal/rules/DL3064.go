@@ -0,0 +1,61 @@
+package rules
+
+/*
+ * file: internal/rules/DL3064.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// secretValue flags credentials written literally into ENV, ARG, LABEL or RUN.
+type secretValue struct{}
+
+// NewSecretValue constructs the rule.
+func NewSecretValue() engine.Rule { return secretValue{} }
+
+// ID returns the rule identifier.
+func (secretValue) ID() string { return "DL3064" }
+
+// Metadata describes the rule.
+func (secretValue) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Do not hard-code secrets",
+		Description: "AWS access keys, GitHub tokens, private keys, JSON Web Tokens, `Authorization` header credentials and other high-entropy strings in `ENV`, `ARG`, `LABEL` or `RUN` end up in the image and its history. Mount secrets with `RUN --mount=type=secret` instead. Findings show only a redacted prefix of the secret.",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"secrets", "env", "arg", "label", "run"},
+		Severity:    engine.SeverityError,
+		DocsURL:     docsURL("DL3064"),
+	}
+}
+
+// secretInstructions lists the instructions whose text is scanned for secrets.
+var secretInstructions = map[string]struct{}{"env": {}, "arg": {}, "label": {}, "run": {}}
+
+// Check scans instruction text, heredocs included, for secrets and reports
+// each kind once per instruction with the value redacted.
+func (secretValue) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	for _, n := range d.AST.Children {
+		if _, ok := secretInstructions[strings.ToLower(n.Value)]; !ok {
+			continue
+		}
+		for _, m := range findSecrets(instructionArgs(n)) {
+			findings = append(findings, engine.Finding{
+				RuleID:  "DL3064",
+				Message: fmt.Sprintf("%s contains %s (%s); %s", strings.ToUpper(n.Value), m.Kind, redact(m.Value), secretAdvice),
+				Line:    n.StartLine,
+			})
+		}
+	}
+	return findings, nil
+}
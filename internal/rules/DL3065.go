@@ -0,0 +1,143 @@
+package rules
+
+/*
+ * file: internal/rules/DL3065.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+	"github.com/asymmetric-effort/docker-lint/internal/shell"
+)
+
+// substitutionRunners lists builtins that execute code produced by a substitution.
+var substitutionRunners = map[string]struct{}{"eval": {}, "source": {}, ".": {}}
+
+// noRemoteExec flags remote downloads executed by an interpreter without verification.
+type noRemoteExec struct{}
+
+// NewNoRemoteExec constructs the rule.
+func NewNoRemoteExec() engine.Rule { return noRemoteExec{} }
+
+// ID returns the rule identifier.
+func (noRemoteExec) ID() string { return "DL3065" }
+
+// Metadata describes the rule.
+func (noRemoteExec) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Do not pipe remote downloads into an interpreter",
+		Description: "Running a script straight from `curl` or `wget`, as in `curl ... | sh`, `wget -O- ... | bash` or `bash <(curl ...)`, executes whatever the server returns. Download the script to a file, verify its checksum and then run it.",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"run", "curl", "wget", "supply-chain"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3065"),
+	}
+}
+
+// Check reports pipelines that feed a download into an interpreter reading
+// standard input, and interpreters whose program is a process or command
+// substitution that downloads.
+func (noRemoteExec) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	for _, n := range d.Instructions("run") {
+		for _, s := range d.RunScripts(n) {
+			report := func(downloader, interpreter string, line int) {
+				findings = append(findings, engine.Finding{
+					RuleID:  "DL3065",
+					Message: fmt.Sprintf("Remote content downloaded with %s is executed by %s; download it to a file, verify its checksum (e.g. sha256sum -c) and then run it", downloader, interpreter),
+					Line:    s.Line + line - 1,
+				})
+			}
+			shell.Inspect(s.List, func(node shell.Node) bool {
+				switch node := node.(type) {
+				case *shell.Pipeline:
+					if dl, interp, line := pipedDownload(node); interp != "" {
+						report(dl, interp, line)
+					}
+				case *shell.SimpleCommand:
+					if dl := substitutedDownload(node); dl != "" {
+						report(dl, commandName(unwrapCommand(node.Argv())), node.Line)
+					}
+				}
+				return true
+			})
+		}
+	}
+	return findings, nil
+}
+
+// pipedDownload returns the downloader and the interpreter of a pipeline in
+// which a download to standard output is followed by an interpreter reading
+// its program from standard input, with the line of the interpreter.
+func pipedDownload(p *shell.Pipeline) (string, string, int) {
+	downloader := ""
+	for _, c := range p.Cmds {
+		sc, ok := c.(*shell.SimpleCommand)
+		if !ok {
+			continue
+		}
+		argv := unwrapCommand(sc.Argv())
+		if len(argv) == 0 {
+			continue
+		}
+		if downloader == "" && downloadsToStdout(argv) {
+			downloader = commandName(argv)
+			continue
+		}
+		if downloader != "" && isInterpreter(commandName(argv)) && interpreterReadsStdin(argv) {
+			return downloader, commandName(argv), sc.Line
+		}
+	}
+	return "", "", 0
+}
+
+// substitutedDownload returns the downloader whose output an interpreter, eval
+// or source runs through an argument or input redirection consisting of a
+// single substitution, such as bash <(curl ...) or sh -c "$(curl ...)".
+func substitutedDownload(c *shell.SimpleCommand) string {
+	argv := unwrapCommand(c.Argv())
+	if len(argv) == 0 {
+		return ""
+	}
+	name := commandName(argv)
+	if _, ok := substitutionRunners[name]; !ok && !isInterpreter(name) {
+		return ""
+	}
+	words := c.Args[len(c.Args)-len(argv)+1:]
+	for _, r := range c.Redirs {
+		if r.Op == "<" && r.Target != nil {
+			words = append(words, r.Target)
+		}
+	}
+	for _, w := range words {
+		if len(w.Subs) != 1 || !wholeSubstitution(w.Raw) {
+			continue
+		}
+		for _, sub := range shell.Commands(w.Subs[0]) {
+			if argv := unwrapCommand(sub.Argv()); downloadsToStdout(argv) {
+				return commandName(argv)
+			}
+		}
+	}
+	return ""
+}
+
+// wholeSubstitution reports whether a raw word, with surrounding double quotes
+// removed, consists of a single $( ), backtick or <( ) substitution.
+func wholeSubstitution(raw string) bool {
+	raw = strings.TrimSuffix(strings.TrimPrefix(raw, `"`), `"`)
+	for _, open := range []string{"$(", "<(", "`"} {
+		if strings.HasPrefix(raw, open) {
+			return strings.HasSuffix(raw, ")") || (open == "`" && strings.HasSuffix(raw, "`"))
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+// file: internal/rules/DL3065_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestIntegrationNoRemoteExecID validates rule identity.
+func TestIntegrationNoRemoteExecID(t *testing.T) {
+	if NewNoRemoteExec().ID() != "DL3065" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationNoRemoteExecViolation detects downloads executed by interpreters.
+func TestIntegrationNoRemoteExecViolation(t *testing.T) {
+	cases := []string{
+		"RUN curl -fsSL https://example.com/install.sh | sh",
+		"RUN wget -O- https://example.com/install.sh | bash",
+		"RUN wget -qO - https://example.com/install.sh | sudo bash -s -- --yes",
+		"RUN curl -sSL https://example.com/get.py | python3 -",
+		"RUN curl -sL https://example.com/setup | tee /tmp/log | perl",
+		"RUN curl -fsSL https://deb.nodesource.com/setup_20.x -o- | node",
+		"RUN bash <(curl -fsSL https://example.com/install.sh)",
+		"RUN python3 < <(wget -qO- https://example.com/get.py)",
+		"RUN sh -c \"$(curl -fsSL https://example.com/install.sh)\"",
+		"RUN eval \"$(curl -fsSL https://example.com/env)\"",
+		"RUN apt-get update && \\\n    curl -fsSL https://example.com/install.sh | bash",
+		"RUN [\"sh\", \"-c\", \"curl -fsSL https://example.com/install.sh | sh\"]",
+	}
+	for _, c := range cases {
+		findings := checkRule(t, NewNoRemoteExec(), "FROM alpine:3.19\n"+c+"\n")
+		if len(findings) != 1 {
+			t.Fatalf("%q: expected one finding, got %#v", c, findings)
+		}
+		if !strings.Contains(findings[0].Message, "checksum") {
+			t.Fatalf("%q: message lacks advice: %q", c, findings[0].Message)
+		}
+	}
+}
+
+// TestIntegrationNoRemoteExecHeredoc reports the heredoc line of the interpreter.
+func TestIntegrationNoRemoteExecHeredoc(t *testing.T) {
+	src := "FROM alpine:3.19\nRUN <<EOF\napk add curl\ncurl -fsSL https://example.com/install.sh | sh\nEOF\n"
+	findings := checkRule(t, NewNoRemoteExec(), src)
+	if len(findings) != 1 || findings[0].Line != 4 {
+		t.Fatalf("expected finding on line 4, got %#v", findings)
+	}
+}
+
+// TestIntegrationNoRemoteExecClean ignores downloads saved to files or parsed as data.
+func TestIntegrationNoRemoteExecClean(t *testing.T) {
+	cases := []string{
+		"RUN curl -fsSLo install.sh https://example.com/install.sh && echo \"abc  install.sh\" | sha256sum -c - && sh install.sh",
+		"RUN curl -fsSL -O https://example.com/install.sh | sh",
+		"RUN wget https://example.com/install.sh | sh",
+		"RUN curl -s https://api.example.com/v | python3 -c 'import json,sys; print(json.load(sys.stdin))'",
+		"RUN curl -s https://example.com/x | perl -ne 'print if /v/'",
+		"RUN curl -s https://example.com/x | node -e 'process.stdin.pipe(process.stdout)'",
+		"RUN curl -fsSL https://example.com/key | gpg --dearmor -o /usr/share/keyrings/x.gpg",
+		"RUN echo \"$(curl -s https://example.com/ip)\" | sh",
+		"RUN sh ./install.sh < <(echo yes)",
+		"RUN bash -c \"echo $(curl -s https://example.com/ip)\"",
+	}
+	for _, c := range cases {
+		if findings := checkRule(t, NewNoRemoteExec(), "FROM alpine:3.19\n"+c+"\n"); len(findings) != 0 {
+			t.Fatalf("%q: expected no findings, got %#v", c, findings)
+		}
+	}
+}
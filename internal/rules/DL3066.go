@@ -0,0 +1,234 @@
+package rules
+
+/*
+ * file: internal/rules/DL3066.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// trustedAptSource matches apt source options that skip signature verification.
+var trustedAptSource = regexp.MustCompile(`\[[^\]]*\btrusted=yes\b[^\]]*\]`)
+
+// insecureEnv maps environment variables that disable verification to a
+// predicate on their value and a description of the effect.
+var insecureEnv = map[string]struct {
+	insecure func(string) bool
+	effect   string
+}{
+	"GIT_SSL_NO_VERIFY":            {func(v string) bool { return v != "" && !falsy(v) }, "disables git TLS certificate verification"},
+	"NODE_TLS_REJECT_UNAUTHORIZED": {falsy, "disables Node.js TLS certificate verification"},
+	"NPM_CONFIG_STRICT_SSL":        {falsy, "disables npm TLS certificate verification"},
+	"PIP_TRUSTED_HOST":             {func(v string) bool { return v != "" }, "disables pip TLS certificate verification for the listed hosts"},
+	"PYTHONHTTPSVERIFY":            {falsy, "disables Python TLS certificate verification"},
+}
+
+// falsy reports whether a configuration value turns a setting off.
+func falsy(v string) bool {
+	switch strings.ToLower(strings.Trim(v, `"'`)) {
+	case "0", "false", "no", "off":
+		return true
+	}
+	return false
+}
+
+// noInsecureTransport flags disabled TLS certificate or package signature verification.
+type noInsecureTransport struct{}
+
+// NewNoInsecureTransport constructs the rule.
+func NewNoInsecureTransport() engine.Rule { return noInsecureTransport{} }
+
+// ID returns the rule identifier.
+func (noInsecureTransport) ID() string { return "DL3066" }
+
+// Metadata describes the rule.
+func (noInsecureTransport) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Do not disable TLS or signature verification",
+		Description: "Options such as `curl -k`, `wget --no-check-certificate`, `pip --trusted-host`, `npm config set strict-ssl false`, `apt-get --allow-unauthenticated`, `[trusted=yes]` apt sources, `apk --allow-untrusted`, `yum`/`dnf --nogpgcheck`, `git -c http.sslVerify=false` and variables like `GIT_SSL_NO_VERIFY` or `NODE_TLS_REJECT_UNAUTHORIZED=0` let a network attacker substitute what the build downloads and installs.",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"run", "env", "tls", "signatures", "supply-chain"},
+		Severity:    engine.SeverityError,
+		DocsURL:     docsURL("DL3066"),
+	}
+}
+
+// Check reports insecure package manager and downloader options in RUN,
+// trusted apt sources written by RUN, and insecure ENV settings, including
+// variables assigned on RUN command lines.
+func (noInsecureTransport) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	report := func(msg string, line int) {
+		findings = append(findings, engine.Finding{RuleID: "DL3066", Message: msg, Line: line})
+	}
+	for _, n := range d.AST.Children {
+		switch strings.ToLower(n.Value) {
+		case "env":
+			// The parser emits each pair as key, value and separator nodes.
+			for tok := n.Next; tok != nil && tok.Next != nil; {
+				if msg := insecureEnvSetting(tok.Value, tok.Next.Value); msg != "" {
+					report(msg, n.StartLine)
+				}
+				if tok = tok.Next.Next; tok != nil {
+					tok = tok.Next
+				}
+			}
+		case "run":
+			src := instructionArgs(n)
+			for _, m := range shellAssignment.FindAllStringSubmatch(src, -1) {
+				if msg := insecureEnvSetting(m[1], m[2]); msg != "" {
+					report(msg, n.StartLine)
+				}
+			}
+			if trustedAptSource.MatchString(src) {
+				report("apt source option [trusted=yes] disables repository signature verification", n.StartLine)
+			}
+			for _, c := range d.RunCommands(n) {
+				if msg := insecureCommand(unwrapCommand(c.Argv)); msg != "" {
+					report(msg, c.Line)
+				}
+			}
+		}
+	}
+	return findings, nil
+}
+
+// insecureEnvSetting describes the effect of setting name to value, or
+// returns an empty string when the setting is harmless.
+func insecureEnvSetting(name, value string) string {
+	e, ok := insecureEnv[strings.ToUpper(name)]
+	if !ok || !e.insecure(strings.Trim(value, `"'`)) {
+		return ""
+	}
+	return fmt.Sprintf("%s %s", name, e.effect)
+}
+
+// insecureCommand describes the verification that argv disables, or returns
+// an empty string when argv is not an insecure download or package operation.
+func insecureCommand(argv []string) string {
+	if len(argv) == 0 {
+		return ""
+	}
+	seg := lowerSlice(argv)
+	name := commandName(argv)
+	switch ecosystemOf(name) {
+	case "node":
+		if slices.Contains(seg, "--strict-ssl=false") || slices.Contains(seg, "--no-strict-ssl") {
+			return name + " --strict-ssl=false disables TLS certificate verification"
+		}
+		if i := indexOf(seg, "set"); i > 0 && i+1 < len(seg) {
+			key, val, ok := strings.Cut(seg[i+1], "=")
+			if !ok && i+2 < len(seg) {
+				val = seg[i+2]
+			}
+			if key == "strict-ssl" && falsy(val) {
+				return name + " config set strict-ssl false disables TLS certificate verification"
+			}
+		}
+	case "apt":
+		for _, f := range []string{"--allow-unauthenticated", "--allow-insecure-repositories"} {
+			if containsFlag(seg, f) {
+				return name + " " + f + " disables package signature verification"
+			}
+		}
+	case "apk":
+		if containsFlag(seg, "--allow-untrusted") {
+			return name + " --allow-untrusted disables package signature verification"
+		}
+	case "rpm":
+		if containsFlag(seg, "--nogpgcheck") {
+			return name + " --nogpgcheck disables package signature verification"
+		}
+		for _, a := range seg {
+			if opt, ok := strings.CutPrefix(a, "--setopt="); ok {
+				if key, val, _ := strings.Cut(opt, "="); (strings.HasSuffix(key, "gpgcheck") || strings.HasSuffix(key, "sslverify")) && falsy(val) {
+					return name + " --setopt=" + key + "=" + val + " disables verification"
+				}
+			}
+		}
+	case "zypper":
+		if containsFlag(seg, "--no-gpg-checks") {
+			return name + " --no-gpg-checks disables package signature verification"
+		}
+	}
+	switch {
+	case name == "curl":
+		if curlInsecure(argv[1:]) {
+			return "curl --insecure disables TLS certificate verification"
+		}
+	case name == "wget":
+		if containsFlag(seg, "--no-check-certificate") {
+			return "wget --no-check-certificate disables TLS certificate verification"
+		}
+	case isPip(name) || (strings.HasPrefix(name, "python") && len(seg) > 2 && seg[1] == "-m" && isPip(seg[2])):
+		if containsFlag(seg, "--trusted-host") || slices.Contains(seg, "global.trusted-host") {
+			return "pip --trusted-host disables TLS certificate verification for the host"
+		}
+	case name == "git":
+		if gitSSLVerifyDisabled(seg[1:]) {
+			return "git http.sslVerify=false disables TLS certificate verification"
+		}
+	}
+	return ""
+}
+
+// curlInsecure reports whether curl arguments pass -k or --insecure,
+// including -k within a cluster of short options.
+func curlInsecure(args []string) bool {
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		if a == "--insecure" || a == "--proxy-insecure" {
+			return true
+		}
+		if !strings.HasPrefix(a, "-") || strings.HasPrefix(a, "--") {
+			continue
+		}
+		for j := 1; j < len(a); j++ {
+			if a[j] == 'k' {
+				return true
+			}
+			if strings.IndexByte(curlValueOptions+"o", a[j]) >= 0 {
+				if j == len(a)-1 {
+					i++
+				}
+				break
+			}
+		}
+	}
+	return false
+}
+
+// gitSSLVerifyDisabled reports whether lowercase git arguments set
+// http.sslVerify, globally or for a URL, to false through -c or git config.
+func gitSSLVerifyDisabled(args []string) bool {
+	isKey := func(k string) bool {
+		return k == "http.sslverify" || (strings.HasPrefix(k, "http.") && strings.HasSuffix(k, ".sslverify"))
+	}
+	for i, a := range args {
+		if a == "-c" && i+1 < len(args) {
+			if key, val, ok := strings.Cut(args[i+1], "="); ok && isKey(key) && falsy(val) {
+				return true
+			}
+		}
+		if a == "config" {
+			for j := i + 1; j+1 < len(args); j++ {
+				if isKey(args[j]) && falsy(args[j+1]) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+// file: internal/rules/DL3066_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import "testing"
+
+// TestIntegrationNoInsecureTransportID validates rule identity.
+func TestIntegrationNoInsecureTransportID(t *testing.T) {
+	if NewNoInsecureTransport().ID() != "DL3066" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationNoInsecureTransportViolation detects disabled verification.
+func TestIntegrationNoInsecureTransportViolation(t *testing.T) {
+	cases := []string{
+		"RUN curl -k https://example.com/a",
+		"RUN curl -fsSLk https://example.com/a -o a",
+		"RUN curl --insecure https://example.com/a",
+		"RUN wget --no-check-certificate https://example.com/a",
+		"RUN pip install --trusted-host pypi.example.com flask==3.0.0",
+		"RUN python3 -m pip install --trusted-host=pypi.example.com flask==3.0.0",
+		"RUN pip config set global.trusted-host pypi.example.com",
+		"RUN npm config set strict-ssl false",
+		"RUN yarn config set strict-ssl false",
+		"RUN npm install --strict-ssl=false left-pad@1.3.0",
+		"RUN apt-get install -y --allow-unauthenticated curl=7.88.1-10",
+		"RUN echo 'deb [trusted=yes] http://repo.example.com stable main' > /etc/apt/sources.list.d/x.list",
+		"RUN apk add --allow-untrusted /tmp/pkg.apk",
+		"RUN yum install -y --nogpgcheck httpd-2.4.57",
+		"RUN dnf install -y --setopt=sslverify=false httpd-2.4.57",
+		"RUN microdnf --nogpgcheck install httpd-2.4.57",
+		"RUN zypper --no-gpg-checks install -y httpd=2.4.57",
+		"RUN git -c http.sslVerify=false clone https://example.com/repo.git",
+		"RUN git config --global http.sslVerify false",
+		"RUN GIT_SSL_NO_VERIFY=1 git clone https://example.com/repo.git",
+		"ENV GIT_SSL_NO_VERIFY=true",
+		"ENV NODE_TLS_REJECT_UNAUTHORIZED=0",
+		"ENV NPM_CONFIG_STRICT_SSL false",
+		"RUN sudo curl -k https://example.com/a",
+	}
+	for _, c := range cases {
+		if findings := checkRule(t, NewNoInsecureTransport(), "FROM alpine:3.19\n"+c+"\n"); len(findings) != 1 || findings[0].Line != 2 {
+			t.Fatalf("%q: expected one finding on line 2, got %#v", c, findings)
+		}
+	}
+}
+
+// TestIntegrationNoInsecureTransportClean ignores verified transports.
+func TestIntegrationNoInsecureTransportClean(t *testing.T) {
+	cases := []string{
+		"RUN curl -fsSL https://example.com/a -o a",
+		"RUN curl -K /etc/curlrc https://example.com/a",
+		"RUN curl -H 'X-k: v' https://example.com/a",
+		"RUN wget https://example.com/a",
+		"RUN pip install flask==3.0.0",
+		"RUN npm config set strict-ssl true",
+		"RUN apt-get install -y curl=7.88.1-10",
+		"RUN echo 'deb [signed-by=/usr/share/keyrings/x.gpg] https://repo.example.com stable main' > /etc/apt/sources.list.d/x.list",
+		"RUN dnf install -y --setopt=install_weak_deps=false httpd-2.4.57",
+		"RUN git -c http.sslVerify=true clone https://example.com/repo.git",
+		"ENV NODE_TLS_REJECT_UNAUTHORIZED=1",
+		"ENV GIT_SSL_NO_VERIFY=false",
+	}
+	for _, c := range cases {
+		if findings := checkRule(t, NewNoInsecureTransport(), "FROM alpine:3.19\n"+c+"\n"); len(findings) != 0 {
+			t.Fatalf("%q: expected no findings, got %#v", c, findings)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package rules
+
+/*
+ * file: internal/rules/DL3067.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// addChecksum requires --checksum on ADD instructions that download URLs.
+type addChecksum struct{}
+
+// NewAddChecksum constructs the rule.
+func NewAddChecksum() engine.Rule { return addChecksum{} }
+
+// ID returns the rule identifier.
+func (addChecksum) ID() string { return "DL3067" }
+
+// Metadata describes the rule.
+func (addChecksum) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Verify remote ADD sources with --checksum",
+		Description: "`ADD https://...` downloads whatever the server returns at build time. Add `--checksum=sha256:<digest>` so the build fails when the content changes.",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"add", "checksum", "supply-chain"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3067"),
+	}
+}
+
+// Check reports ADD instructions with HTTP(S) sources, other than Git
+// repositories, that lack a --checksum flag.
+func (addChecksum) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	for _, n := range d.Instructions("add") {
+		if _, ok := addFlag(n, "checksum"); ok {
+			continue
+		}
+		tokens := collectArgs(n)
+		if len(tokens) < 2 {
+			continue
+		}
+		for _, src := range tokens[:len(tokens)-1] {
+			if isURL(src) && !isGitSource(src) {
+				findings = append(findings, engine.Finding{
+					RuleID:  "DL3067",
+					Message: "Add --checksum=sha256:<digest> to ADD " + redactSource(src) + " to verify the download",
+					Line:    n.StartLine,
+				})
+				break
+			}
+		}
+	}
+	return findings, nil
+}
@@ -0,0 +1,92 @@
+package rules
+
+/*
+ * file: internal/rules/DL3068.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// commitSHA matches a full SHA-1 or SHA-256 Git object name.
+var commitSHA = regexp.MustCompile(`^(?:[0-9a-f]{40}|[0-9a-f]{64})$`)
+
+// addGitPinned requires ADD of Git repositories to pin a commit.
+type addGitPinned struct{}
+
+// NewAddGitPinned constructs the rule.
+func NewAddGitPinned() engine.Rule { return addGitPinned{} }
+
+// ID returns the rule identifier.
+func (addGitPinned) ID() string { return "DL3068" }
+
+// Metadata describes the rule.
+func (addGitPinned) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Pin Git ADD sources to a commit",
+		Description: "`ADD git@host:repo.git` and `ADD https://host/repo.git#ref` follow a branch or tag that can move. Reference a full commit SHA with `#<sha>` or `?ref=<sha>`, or verify the commit with `--checksum=<sha>`.",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"add", "git", "pinning", "supply-chain"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3068"),
+	}
+}
+
+// Check reports ADD instructions whose Git sources are not pinned to a commit.
+func (addGitPinned) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	for _, n := range d.Instructions("add") {
+		if sum, ok := addFlag(n, "checksum"); ok && commitSHA.MatchString(strings.ToLower(sum)) {
+			continue
+		}
+		tokens := collectArgs(n)
+		if len(tokens) < 2 {
+			continue
+		}
+		for _, src := range tokens[:len(tokens)-1] {
+			if isGitSource(src) && !commitSHA.MatchString(gitSourceRef(src)) {
+				findings = append(findings, engine.Finding{
+					RuleID:  "DL3068",
+					Message: "Pin ADD " + redactSource(src) + " to a full commit SHA, e.g. #<sha> or --checksum=<sha>",
+					Line:    n.StartLine,
+				})
+				break
+			}
+		}
+	}
+	return findings, nil
+}
+
+// gitSourceRef returns the lowercase ref of a Git source, taken from the
+// ref or commit query parameter or from the fragment, without a subdirectory.
+func gitSourceRef(src string) string {
+	src = strings.ToLower(src)
+	if i := strings.IndexByte(src, '?'); i >= 0 {
+		query := src[i+1:]
+		if j := strings.IndexByte(query, '#'); j >= 0 {
+			query = query[:j]
+		}
+		if q, err := url.ParseQuery(query); err == nil {
+			for _, key := range []string{"commit", "ref"} {
+				if v := q.Get(key); v != "" {
+					return v
+				}
+			}
+		}
+	}
+	if i := strings.IndexByte(src, '#'); i >= 0 {
+		ref, _, _ := strings.Cut(src[i+1:], ":")
+		return ref
+	}
+	return ""
+}
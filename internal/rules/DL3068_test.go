@@ -0,0 +1,33 @@
+// file: internal/rules/DL3068_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import "testing"
+
+// TestIntegrationAddGitPinnedID validates rule identity.
+func TestIntegrationAddGitPinnedID(t *testing.T) {
+	if NewAddGitPinned().ID() != "DL3068" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationAddGitPinned requires Git ADD sources to reference a commit.
+func TestIntegrationAddGitPinned(t *testing.T) {
+	const sha = "9e0d2c6b7a3f4e5d8c1b0a9f8e7d6c5b4a3f2e1d"
+	cases := map[string]int{
+		"ADD git@github.com:moby/buildkit.git /src":                                    1,
+		"ADD https://github.com/moby/buildkit.git#v0.12.0 /src":                        1,
+		"ADD https://github.com/moby/buildkit.git?ref=main&subdir=docs /src":           1,
+		"ADD git://example.com/repo.git#main:sub /src":                                 1,
+		"ADD https://github.com/moby/buildkit.git#" + sha + " /src":                    0,
+		"ADD https://github.com/moby/buildkit.git#" + sha + ":docs /src":               0,
+		"ADD https://github.com/moby/buildkit.git?ref=" + sha + "&keep-git-dir=1 /src": 0,
+		"ADD --checksum=" + sha + " https://github.com/moby/buildkit.git#v0.12.0 /src": 0,
+		"ADD https://example.com/tool /usr/local/bin/tool":                             0,
+	}
+	for line, want := range cases {
+		if findings := checkRule(t, NewAddGitPinned(), "FROM alpine:3.19\n"+line+"\n"); len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %#v", line, want, findings)
+		}
+	}
+}
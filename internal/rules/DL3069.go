@@ -0,0 +1,59 @@
+package rules
+
+/*
+ * file: internal/rules/DL3069.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// addRemoteArchive flags remote archives added in the expectation that ADD extracts them.
+type addRemoteArchive struct{}
+
+// NewAddRemoteArchive constructs the rule.
+func NewAddRemoteArchive() engine.Rule { return addRemoteArchive{} }
+
+// ID returns the rule identifier.
+func (addRemoteArchive) ID() string { return "DL3069" }
+
+// Metadata describes the rule.
+func (addRemoteArchive) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "ADD does not extract remote archives",
+		Description: "`ADD` only extracts local tar archives; an archive downloaded from a URL is copied into the image as a single file. Download it with `ADD --checksum` and extract it with `tar` in a `RUN` instruction, or fetch, verify and extract it in one `RUN` so the archive does not remain in a layer.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"add", "archive"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3069"),
+	}
+}
+
+// Check reports ADD instructions that download an archive from a URL.
+func (addRemoteArchive) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	for _, n := range d.Instructions("add") {
+		tokens := collectArgs(n)
+		if len(tokens) < 2 {
+			continue
+		}
+		for _, src := range tokens[:len(tokens)-1] {
+			if isURL(src) && !isGitSource(src) && isArchive(sourcePath(src)) {
+				findings = append(findings, engine.Finding{
+					RuleID:  "DL3069",
+					Message: "ADD does not extract the remote archive " + redactSource(src) + "; extract it with tar in a RUN instruction",
+					Line:    n.StartLine,
+				})
+				break
+			}
+		}
+	}
+	return findings, nil
+}
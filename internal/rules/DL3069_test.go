@@ -0,0 +1,29 @@
+// file: internal/rules/DL3069_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import "testing"
+
+// TestIntegrationAddRemoteArchiveID validates rule identity.
+func TestIntegrationAddRemoteArchiveID(t *testing.T) {
+	if NewAddRemoteArchive().ID() != "DL3069" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationAddRemoteArchive flags archives downloaded by ADD.
+func TestIntegrationAddRemoteArchive(t *testing.T) {
+	cases := map[string]int{
+		"ADD https://example.com/node-v20.tar.gz /opt/":                   1,
+		"ADD https://example.com/download.tgz?version=2 /opt/":            1,
+		"ADD --checksum=sha256:abc https://example.com/tool.tar.xz /opt/": 1,
+		"ADD https://example.com/tool /usr/local/bin/tool":                0,
+		"ADD tool.tar.gz /opt/":                                           0,
+		"ADD https://github.com/moby/buildkit.git /src":                   0,
+	}
+	for line, want := range cases {
+		if findings := checkRule(t, NewAddRemoteArchive(), "FROM alpine:3.19\n"+line+"\n"); len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %#v", line, want, findings)
+		}
+	}
+}
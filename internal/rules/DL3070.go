@@ -0,0 +1,60 @@
+package rules
+
+/*
+ * file: internal/rules/DL3070.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"strings"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// explicitUser requires the target stage to set a USER.
+type explicitUser struct{}
+
+// NewExplicitUser constructs the rule.
+func NewExplicitUser() engine.Rule { return explicitUser{} }
+
+// ID returns the rule identifier.
+func (explicitUser) ID() string { return "DL3070" }
+
+// Metadata describes the rule.
+func (explicitUser) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Final stage runs as root implicitly",
+		Description: "Without a `USER` instruction the container runs as the base image's user, which is root for most images. Create an unprivileged user and switch to it with `USER`. Base images tagged `nonroot` are assumed to set one. The rule is opt-in because other base images may set a `USER` too.",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"user", "root"},
+		Severity:    engine.SeverityWarning,
+		OptIn:       true,
+		DocsURL:     docsURL("DL3070"),
+	}
+}
+
+// Check reports the target stage when neither it nor the stages it inherits
+// from via FROM set a USER.
+func (explicitUser) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	target := d.TargetStage()
+	if target == nil || len(stageUsers(d, target)) > 0 {
+		return findings, nil
+	}
+	lineage := stageLineage(d, target)
+	base := lineage[len(lineage)-1]
+	if image, _ := d.Expand(base.Node, base.From); strings.Contains(strings.ToLower(image), "nonroot") {
+		return findings, nil
+	}
+	findings = append(findings, engine.Finding{
+		RuleID:  "DL3070",
+		Message: "No USER is set in the final stage, so the container runs as root; add a non-root USER",
+		Line:    target.Node.StartLine,
+	})
+	return findings, nil
+}
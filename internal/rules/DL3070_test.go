@@ -0,0 +1,30 @@
+// file: internal/rules/DL3070_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import "testing"
+
+// TestIntegrationExplicitUserID validates rule identity.
+func TestIntegrationExplicitUserID(t *testing.T) {
+	if NewExplicitUser().ID() != "DL3070" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationExplicitUser reports final stages without any USER.
+func TestIntegrationExplicitUser(t *testing.T) {
+	cases := map[string]int{
+		"FROM alpine:3.19\nRUN echo hi\n":                                        1,
+		"FROM alpine:3.19 AS base\nUSER app\nFROM alpine:3.19\nRUN echo hi\n":    1,
+		"FROM alpine:3.19 AS base\nUSER 10001\nFROM base\nRUN echo hi\n":         0,
+		"FROM alpine:3.19\nUSER root\n":                                          0,
+		"FROM gcr.io/distroless/static-debian12:nonroot\nCOPY app /app\n":        0,
+		"ARG BASE=gcr.io/distroless/base:nonroot\nFROM ${BASE}\nCOPY app /app\n": 0,
+	}
+	for src, want := range cases {
+		findings := checkRule(t, NewExplicitUser(), src)
+		if len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %#v", src, want, findings)
+		}
+	}
+}
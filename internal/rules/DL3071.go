@@ -0,0 +1,66 @@
+package rules
+
+/*
+ * file: internal/rules/DL3071.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// noRootSwitchBack flags stages that return to root after dropping privileges.
+type noRootSwitchBack struct{}
+
+// NewNoRootSwitchBack constructs the rule.
+func NewNoRootSwitchBack() engine.Rule { return noRootSwitchBack{} }
+
+// ID returns the rule identifier.
+func (noRootSwitchBack) ID() string { return "DL3071" }
+
+// Metadata describes the rule.
+func (noRootSwitchBack) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Do not switch back to root",
+		Description: "A stage that switches to root after running as a non-root user, for example to install packages, must switch back with another `USER` before it ends; otherwise the stage and the stages built `FROM` it run as root.",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"user", "root"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3071"),
+	}
+}
+
+// Check reports, for each stage, a USER root following a non-root USER,
+// including users inherited through FROM <stage>, when no non-root USER
+// follows it before the end of the stage.
+func (noRootSwitchBack) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	for _, st := range d.Stages {
+		prev := ""
+		var back *userSwitch
+		for _, u := range stageUsers(d, st) {
+			switch {
+			case !isRootUser(u.User):
+				back = nil
+			case prev != "" && !isRootUser(prev):
+				back = &u
+			}
+			prev = u.User
+		}
+		if back == nil || d.StageOf(back.Node) != st {
+			continue
+		}
+		findings = append(findings, engine.Finding{
+			RuleID:  "DL3071",
+			Message: "USER switches back to root and the stage ends without returning to a non-root user",
+			Line:    back.Node.StartLine,
+		})
+	}
+	return findings, nil
+}
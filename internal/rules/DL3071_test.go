@@ -0,0 +1,38 @@
+// file: internal/rules/DL3071_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import "testing"
+
+// TestIntegrationNoRootSwitchBackID validates rule identity.
+func TestIntegrationNoRootSwitchBackID(t *testing.T) {
+	if NewNoRootSwitchBack().ID() != "DL3071" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationNoRootSwitchBack reports stages left as root after dropping privileges.
+func TestIntegrationNoRootSwitchBack(t *testing.T) {
+	cases := []struct {
+		src   string
+		lines []int
+	}{
+		{"FROM alpine:3.19\nUSER app\nRUN id\nUSER root\nRUN apk add curl\n", []int{4}},
+		{"FROM alpine:3.19\nUSER app\nUSER root\nRUN apk add curl\nUSER app\n", nil},
+		{"FROM alpine:3.19\nUSER root\nRUN id\n", nil},
+		{"FROM alpine:3.19 AS base\nUSER 1000\nFROM base\nUSER 0\nRUN id\n", []int{4}},
+		{"FROM alpine:3.19 AS base\nUSER app\nUSER root\nFROM base\nRUN id\n", []int{3}},
+		{"FROM alpine:3.19 AS a\nUSER app\nUSER root\nFROM alpine:3.19\nUSER app\nUSER root:root\n", []int{3, 6}},
+	}
+	for _, tc := range cases {
+		findings := checkRule(t, NewNoRootSwitchBack(), tc.src)
+		if len(findings) != len(tc.lines) {
+			t.Fatalf("%q: expected lines %v, got %#v", tc.src, tc.lines, findings)
+		}
+		for i, f := range findings {
+			if f.Line != tc.lines[i] {
+				t.Fatalf("%q: expected lines %v, got %#v", tc.src, tc.lines, findings)
+			}
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package rules
+
+/*
+ * file: internal/rules/DL3072.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"strings"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// numericUser requires the effective USER of the target stage to be a numeric UID.
+type numericUser struct{}
+
+// NewNumericUser constructs the rule.
+func NewNumericUser() engine.Rule { return numericUser{} }
+
+// ID returns the rule identifier.
+func (numericUser) ID() string { return "DL3072" }
+
+// Metadata describes the rule.
+func (numericUser) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Use a numeric UID for the final USER",
+		Description: "Kubernetes `runAsNonRoot` can only verify numeric users; a container whose image sets `USER app` is refused. Use the UID, e.g. `USER 10001` or `USER 10001:10001`.",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"user", "kubernetes"},
+		Severity:    engine.SeverityInfo,
+		OptIn:       true,
+		DocsURL:     docsURL("DL3072"),
+	}
+}
+
+// Check reports the USER that sets a non-root user name in the target stage,
+// following inheritance through FROM <stage>.
+func (numericUser) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	target := d.TargetStage()
+	if target == nil {
+		return findings, nil
+	}
+	users := stageUsers(d, target)
+	if len(users) == 0 {
+		return findings, nil
+	}
+	last := users[len(users)-1]
+	if isRootUser(last.User) || isNumericUser(last.User) || strings.Contains(last.User, "$") {
+		return findings, nil
+	}
+	findings = append(findings, engine.Finding{
+		RuleID:  "DL3072",
+		Message: "USER " + last.User + " is not numeric; use the UID so Kubernetes runAsNonRoot can verify it",
+		Line:    last.Node.StartLine,
+	})
+	return findings, nil
+}
@@ -0,0 +1,33 @@
+// file: internal/rules/DL3072_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import "testing"
+
+// TestIntegrationNumericUserID validates rule identity.
+func TestIntegrationNumericUserID(t *testing.T) {
+	if NewNumericUser().ID() != "DL3072" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationNumericUser reports named users in the final stage.
+func TestIntegrationNumericUser(t *testing.T) {
+	cases := map[string]int{
+		"FROM alpine:3.19\nUSER app\n":                                        1,
+		"FROM alpine:3.19\nUSER app:app\n":                                    1,
+		"FROM alpine:3.19 AS base\nUSER app\nFROM base\nRUN id\n":             1,
+		"ARG UID=app\nFROM alpine:3.19\nARG UID\nUSER ${UID}\n":               1,
+		"FROM alpine:3.19\nUSER 10001\n":                                      0,
+		"FROM alpine:3.19\nUSER 10001:app\n":                                  0,
+		"FROM alpine:3.19\nUSER root\n":                                       0,
+		"FROM alpine:3.19\nARG UID\nUSER $UID\n":                              0,
+		"FROM alpine:3.19\nRUN id\n":                                          0,
+		"FROM alpine:3.19 AS build\nUSER app\nFROM alpine:3.19\nUSER 10001\n": 0,
+	}
+	for src, want := range cases {
+		if findings := checkRule(t, NewNumericUser(), src); len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %#v", src, want, findings)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package rules
+
+/*
+ * file: internal/rules/DL3073.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"strings"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// userCreated requires USER names to be created before they are used.
+type userCreated struct{}
+
+// NewUserCreated constructs the rule.
+func NewUserCreated() engine.Rule { return userCreated{} }
+
+// ID returns the rule identifier.
+func (userCreated) ID() string { return "DL3073" }
+
+// Metadata describes the rule.
+func (userCreated) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "USER references a user that was not created",
+		Description: "A `USER` name that no earlier `useradd` or `adduser` in the stage, or in the stages it is built `FROM`, creates only works when the base image happens to provide it, and the container fails to start otherwise. Create the user, or use a numeric UID.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"user"},
+		Severity:    engine.SeverityWarning,
+		OptIn:       true,
+		DocsURL:     docsURL("DL3073"),
+	}
+}
+
+// Check reports USER instructions naming an account that is neither a common
+// system account nor created by an earlier instruction of the stage lineage.
+func (userCreated) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	for _, st := range d.Stages {
+		lineage := stageLineage(d, st)
+		var seen []*ir.Stage
+		for i := len(lineage) - 1; i > 0; i-- {
+			seen = append(seen, lineage[i])
+		}
+		for i, n := range st.Instructions {
+			if !strings.EqualFold(n.Value, "user") || n.Next == nil {
+				continue
+			}
+			u := expandUser(d, n)
+			name := userName(u)
+			if _, ok := systemUsers[name]; ok || name == "" || isNumericUser(u) || strings.Contains(name, "$") {
+				continue
+			}
+			if userCreatedBefore(d, seen, st.Instructions[:i], name) {
+				continue
+			}
+			findings = append(findings, engine.Finding{
+				RuleID:  "DL3073",
+				Message: "USER " + name + " is not created by useradd or adduser in this stage or the stages it is built from",
+				Line:    n.StartLine,
+			})
+		}
+	}
+	return findings, nil
+}
+
+// userCreatedBefore reports whether the ancestor stages or the preceding
+// instructions of the current stage create the account name.
+func userCreatedBefore(d *ir.Document, ancestors []*ir.Stage, preceding []*parser.Node, name string) bool {
+	for _, st := range ancestors {
+		for _, n := range st.Instructions {
+			if createsUser(d, n, name) {
+				return true
+			}
+		}
+	}
+	for _, n := range preceding {
+		if createsUser(d, n, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,34 @@
+// file: internal/rules/DL3073_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import "testing"
+
+// TestIntegrationUserCreatedID validates rule identity.
+func TestIntegrationUserCreatedID(t *testing.T) {
+	if NewUserCreated().ID() != "DL3073" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationUserCreated reports USER names that were never created.
+func TestIntegrationUserCreated(t *testing.T) {
+	cases := map[string]int{
+		"FROM alpine:3.19\nUSER app\n":                                                                       1,
+		"FROM alpine:3.19\nUSER app\nRUN adduser -D app\n":                                                   1,
+		"FROM alpine:3.19 AS a\nRUN adduser -D app\nFROM alpine:3.19\nUSER app\n":                            1,
+		"FROM alpine:3.19\nRUN adduser -D -u 10001 app\nUSER app\n":                                          0,
+		"FROM debian:12\nRUN groupadd -r app && useradd -r -g app app\nUSER app:app\n":                       0,
+		"FROM alpine:3.19 AS base\nRUN addgroup -S app && adduser -S app -G app\nFROM base\nUSER app\n":      0,
+		"FROM alpine:3.19\nCOPY passwd /etc/passwd\nUSER app\n":                                              0,
+		"FROM alpine:3.19\nRUN echo 'app:x:10001:10001::/home/app:/sbin/nologin' >> /etc/passwd\nUSER app\n": 0,
+		"FROM alpine:3.19\nUSER nobody\n":                                                                    0,
+		"FROM alpine:3.19\nUSER 10001\n":                                                                     0,
+		"FROM alpine:3.19\nARG USER\nUSER $USER\n":                                                           0,
+	}
+	for src, want := range cases {
+		if findings := checkRule(t, NewUserCreated(), src); len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %#v", src, want, findings)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package rules
+
+/*
+ * file: internal/rules/DL3074.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"strings"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// noWorldWritable flags world-writable permissions on broad paths and copied files.
+type noWorldWritable struct{}
+
+// NewNoWorldWritable constructs the rule.
+func NewNoWorldWritable() engine.Rule { return noWorldWritable{} }
+
+// ID returns the rule identifier.
+func (noWorldWritable) ID() string { return "DL3074" }
+
+// Metadata describes the rule.
+func (noWorldWritable) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Do not make files world-writable",
+		Description: "`chmod 777` or `a+w` applied recursively or to system directories, and `COPY --chmod=777`, let any process in the container modify binaries and configuration. Grant write access to the owning user or group only.",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"run", "copy", "chmod", "permissions"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3074"),
+	}
+}
+
+// Check reports recursive or broad chmod calls and COPY or ADD --chmod flags
+// that grant write access to others.
+func (noWorldWritable) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	for _, n := range d.AST.Children {
+		switch kw := strings.ToLower(n.Value); kw {
+		case "copy", "add":
+			if mode, ok := addFlag(n, "chmod"); ok && worldWritableMode(mode) {
+				findings = append(findings, engine.Finding{
+					RuleID:  "DL3074",
+					Message: strings.ToUpper(kw) + " --chmod=" + mode + " makes the copied files world-writable",
+					Line:    n.StartLine,
+				})
+			}
+		case "run":
+			for _, c := range chmodCalls(d, n) {
+				if !worldWritableMode(c.Mode) {
+					continue
+				}
+				if target := broadTarget(c.Paths); c.Recursive || target != "" {
+					if target == "" {
+						target = strings.Join(c.Paths, " ")
+					}
+					findings = append(findings, engine.Finding{
+						RuleID:  "DL3074",
+						Message: "chmod " + c.Mode + " makes " + target + " world-writable",
+						Line:    c.Line,
+					})
+				}
+			}
+		}
+	}
+	return findings, nil
+}
+
+// broadTarget returns the first broad path among paths, or an empty string.
+func broadTarget(paths []string) string {
+	for _, p := range paths {
+		if isBroadPath(p) {
+			return p
+		}
+	}
+	return ""
+}
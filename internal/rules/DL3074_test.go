@@ -0,0 +1,44 @@
+// file: internal/rules/DL3074_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import "testing"
+
+// TestIntegrationNoWorldWritableID validates rule identity.
+func TestIntegrationNoWorldWritableID(t *testing.T) {
+	if NewNoWorldWritable().ID() != "DL3074" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationNoWorldWritable reports world-writable modes on broad paths and copied files.
+func TestIntegrationNoWorldWritable(t *testing.T) {
+	cases := map[string]int{
+		"FROM alpine:3.19\nRUN chmod -R 777 /app\n":                   1,
+		"FROM alpine:3.19\nRUN chmod 777 /usr/local/bin\n":            1,
+		"FROM alpine:3.19\nRUN chmod a+w /etc\n":                      1,
+		"FROM alpine:3.19\nRUN sudo chmod -R o+rw /*\n":               1,
+		"FROM alpine:3.19\nCOPY --chmod=777 app /app\n":               1,
+		"FROM alpine:3.19\nADD --chmod=a+rwx app.tar /app\n":          1,
+		"FROM alpine:3.19\nRUN chmod 777 /app/cache\n":                0,
+		"FROM alpine:3.19\nRUN chmod -R 755 /app\n":                   0,
+		"FROM alpine:3.19\nRUN chmod 1777 /tmp\n":                     0,
+		"FROM alpine:3.19\nRUN chmod -R a+rX /usr/share/app\n":        0,
+		"FROM alpine:3.19\nRUN chmod -R +w /app\n":                    0,
+		"FROM alpine:3.19\nCOPY --chmod=644 app.conf /etc/app.conf\n": 0,
+	}
+	for src, want := range cases {
+		if findings := checkRule(t, NewNoWorldWritable(), src); len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %#v", src, want, findings)
+		}
+	}
+}
+
+// TestIntegrationNoWorldWritableLine reports the line of the chmod command.
+func TestIntegrationNoWorldWritableLine(t *testing.T) {
+	src := "FROM alpine:3.19\nRUN <<EOF\nset -e\nchmod -R 777 /\nEOF\n"
+	findings := checkRule(t, NewNoWorldWritable(), src)
+	if len(findings) != 1 || findings[0].Line != 4 {
+		t.Fatalf("expected one finding on line 4, got %#v", findings)
+	}
+}
@@ -0,0 +1,67 @@
+package rules
+
+/*
+ * file: internal/rules/DL3075.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"strings"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// noSetID flags files given the setuid or setgid bit.
+type noSetID struct{}
+
+// NewNoSetID constructs the rule.
+func NewNoSetID() engine.Rule { return noSetID{} }
+
+// ID returns the rule identifier.
+func (noSetID) ID() string { return "DL3075" }
+
+// Metadata describes the rule.
+func (noSetID) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Do not set the setuid or setgid bit",
+		Description: "Executables with the setuid or setgid bit run with the privileges of their owner or group, typically root, and are a common privilege escalation path. Avoid `chmod +s`, `u+s`, `g+s`, modes such as `4755` and `COPY --chmod=4755`.",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"run", "copy", "chmod", "setuid", "permissions"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3075"),
+	}
+}
+
+// Check reports chmod calls and COPY or ADD --chmod flags that set the
+// setuid or setgid bit.
+func (noSetID) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	for _, n := range d.AST.Children {
+		switch kw := strings.ToLower(n.Value); kw {
+		case "copy", "add":
+			if mode, ok := addFlag(n, "chmod"); ok && setIDMode(mode) {
+				findings = append(findings, engine.Finding{
+					RuleID:  "DL3075",
+					Message: strings.ToUpper(kw) + " --chmod=" + mode + " sets the setuid or setgid bit",
+					Line:    n.StartLine,
+				})
+			}
+		case "run":
+			for _, c := range chmodCalls(d, n) {
+				if setIDMode(c.Mode) {
+					findings = append(findings, engine.Finding{
+						RuleID:  "DL3075",
+						Message: "chmod " + c.Mode + " sets the setuid or setgid bit on " + strings.Join(c.Paths, " "),
+						Line:    c.Line,
+					})
+				}
+			}
+		}
+	}
+	return findings, nil
+}
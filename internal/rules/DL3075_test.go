@@ -0,0 +1,33 @@
+// file: internal/rules/DL3075_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import "testing"
+
+// TestIntegrationNoSetIDID validates rule identity.
+func TestIntegrationNoSetIDID(t *testing.T) {
+	if NewNoSetID().ID() != "DL3075" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationNoSetID reports modes that set the setuid or setgid bit.
+func TestIntegrationNoSetID(t *testing.T) {
+	cases := map[string]int{
+		"FROM alpine:3.19\nRUN chmod +s /usr/bin/app\n":          1,
+		"FROM alpine:3.19\nRUN chmod u+s,g+x /usr/bin/app\n":     1,
+		"FROM alpine:3.19\nRUN chmod 4755 /usr/bin/app\n":        1,
+		"FROM alpine:3.19\nRUN chmod 2755 /srv/shared\n":         1,
+		"FROM alpine:3.19\nCOPY --chmod=4755 app /usr/bin/app\n": 1,
+		"FROM alpine:3.19\nRUN chmod 0755 /usr/bin/app\n":        0,
+		"FROM alpine:3.19\nRUN chmod -s /usr/bin/app\n":          0,
+		"FROM alpine:3.19\nRUN chmod o+s /usr/bin/app\n":         0,
+		"FROM alpine:3.19\nCOPY --chmod=755 app /usr/bin/app\n":  0,
+		"FROM alpine:3.19\nRUN echo chmod +s is not run here\n":  0,
+	}
+	for src, want := range cases {
+		if findings := checkRule(t, NewNoSetID(), src); len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %#v", src, want, findings)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package rules
+
+/*
+ * file: internal/rules/DL3076.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// noRecursiveRootChown flags recursive ownership changes of the root filesystem.
+type noRecursiveRootChown struct{}
+
+// NewNoRecursiveRootChown constructs the rule.
+func NewNoRecursiveRootChown() engine.Rule { return noRecursiveRootChown{} }
+
+// ID returns the rule identifier.
+func (noRecursiveRootChown) ID() string { return "DL3076" }
+
+// Metadata describes the rule.
+func (noRecursiveRootChown) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Do not chown the root filesystem recursively",
+		Description: "`chown -R` or `chgrp -R` on `/` hands every system binary and configuration file to another user and duplicates the whole filesystem into a new layer. Change the ownership of the application directories only.",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"run", "chown", "permissions"},
+		Severity:    engine.SeverityError,
+		DocsURL:     docsURL("DL3076"),
+	}
+}
+
+// Check reports recursive chown and chgrp calls whose operands include / or /*.
+func (noRecursiveRootChown) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	for _, n := range d.Instructions("run") {
+		for _, c := range d.RunCommands(n) {
+			argv := unwrapCommand(c.Argv)
+			if len(argv) < 3 {
+				continue
+			}
+			name := commandName(argv)
+			if name != "chown" && name != "chgrp" {
+				continue
+			}
+			recursive := false
+			root := false
+			for _, a := range argv[1:] {
+				switch {
+				case isRecursiveOption(a):
+					recursive = true
+				case strings.HasPrefix(a, "/"):
+					root = root || path.Clean(a) == "/" || a == "/*"
+				}
+			}
+			if recursive && root {
+				findings = append(findings, engine.Finding{
+					RuleID:  "DL3076",
+					Message: name + " -R changes the ownership of the entire filesystem; limit it to the application directories",
+					Line:    c.Line,
+				})
+			}
+		}
+	}
+	return findings, nil
+}
@@ -0,0 +1,30 @@
+// file: internal/rules/DL3076_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import "testing"
+
+// TestIntegrationNoRecursiveRootChownID validates rule identity.
+func TestIntegrationNoRecursiveRootChownID(t *testing.T) {
+	if NewNoRecursiveRootChown().ID() != "DL3076" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationNoRecursiveRootChown reports recursive ownership changes of /.
+func TestIntegrationNoRecursiveRootChown(t *testing.T) {
+	cases := map[string]int{
+		"FROM alpine:3.19\nRUN chown -R app:app /\n":         1,
+		"FROM alpine:3.19\nRUN chown --recursive 10001 /*\n": 1,
+		"FROM alpine:3.19\nRUN sudo chgrp -hR app /\n":       1,
+		"FROM alpine:3.19\nRUN chown -R app:app /app\n":      0,
+		"FROM alpine:3.19\nRUN chown app:app /\n":            0,
+		"FROM alpine:3.19\nRUN chmod -R 755 /\n":             0,
+		"FROM alpine:3.19\nCOPY --chown=app:app . /\n":       0,
+	}
+	for src, want := range cases {
+		if findings := checkRule(t, NewNoRecursiveRootChown(), src); len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %#v", src, want, findings)
+		}
+	}
+}
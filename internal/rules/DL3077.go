@@ -0,0 +1,89 @@
+package rules
+
+/*
+ * file: internal/rules/DL3077.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"strings"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// dangerousCapabilities lists file capabilities that amount to root-equivalent privileges.
+var dangerousCapabilities = []string{
+	"cap_sys_admin", "cap_net_admin", "cap_sys_module", "cap_sys_ptrace", "cap_sys_rawio",
+	"cap_dac_override", "cap_dac_read_search", "cap_setuid", "cap_setgid",
+}
+
+// noDangerousSetcap flags file capabilities that grant broad privileges.
+type noDangerousSetcap struct{}
+
+// NewNoDangerousSetcap constructs the rule.
+func NewNoDangerousSetcap() engine.Rule { return noDangerousSetcap{} }
+
+// ID returns the rule identifier.
+func (noDangerousSetcap) ID() string { return "DL3077" }
+
+// Metadata describes the rule.
+func (noDangerousSetcap) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Do not grant powerful file capabilities",
+		Description: "`setcap` with `cap_sys_admin`, `cap_net_admin`, `cap_sys_module`, `cap_sys_ptrace` or similar capabilities gives an executable near-root privileges. Grant narrow capabilities such as `cap_net_bind_service` instead.",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"run", "setcap", "capabilities"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3077"),
+	}
+}
+
+// Check reports setcap calls whose capability text names a dangerous capability or all.
+func (noDangerousSetcap) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	for _, n := range d.Instructions("run") {
+		for _, seg := range runSegments(d, n) {
+			if seg.Args[0] != "setcap" && !strings.HasSuffix(seg.Args[0], "/setcap") {
+				continue
+			}
+			if capability := grantedCapability(seg.Args[1:]); capability != "" {
+				findings = append(findings, engine.Finding{
+					RuleID:  "DL3077",
+					Message: "setcap grants " + capability + ", which amounts to root privileges",
+					Line:    seg.Line,
+				})
+			}
+		}
+	}
+	return findings, nil
+}
+
+// grantedCapability returns the first dangerous capability added by setcap
+// arguments such as cap_net_admin,cap_net_raw+ep, or all for all capabilities.
+func grantedCapability(args []string) string {
+	for _, a := range args {
+		if strings.HasPrefix(a, "-") {
+			continue
+		}
+		i := strings.IndexAny(a, "+=")
+		if i < 0 {
+			continue
+		}
+		for _, c := range strings.Split(a[:i], ",") {
+			if c == "all" || (c == "" && a[i] == '=') {
+				return "all capabilities"
+			}
+			for _, dc := range dangerousCapabilities {
+				if c == dc {
+					return c
+				}
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,31 @@
+// file: internal/rules/DL3077_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import "testing"
+
+// TestIntegrationNoDangerousSetcapID validates rule identity.
+func TestIntegrationNoDangerousSetcapID(t *testing.T) {
+	if NewNoDangerousSetcap().ID() != "DL3077" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationNoDangerousSetcap reports setcap calls granting powerful capabilities.
+func TestIntegrationNoDangerousSetcap(t *testing.T) {
+	cases := map[string]int{
+		"FROM alpine:3.19\nRUN setcap cap_sys_admin+ep /usr/bin/app\n":              1,
+		"FROM alpine:3.19\nRUN setcap cap_net_raw,cap_net_admin+eip /usr/bin/app\n": 1,
+		"FROM alpine:3.19\nRUN /usr/sbin/setcap CAP_NET_ADMIN=ep /usr/bin/app\n":    1,
+		"FROM alpine:3.19\nRUN setcap all+ep /usr/bin/app\n":                        1,
+		"FROM alpine:3.19\nRUN setcap =ep /usr/bin/app\n":                           1,
+		"FROM alpine:3.19\nRUN setcap cap_net_bind_service=+ep /usr/bin/app\n":      0,
+		"FROM alpine:3.19\nRUN setcap -r /usr/bin/app\n":                            0,
+		"FROM alpine:3.19\nRUN getcap cap_sys_admin+ep /usr/bin/app\n":              0,
+	}
+	for src, want := range cases {
+		if findings := checkRule(t, NewNoDangerousSetcap(), src); len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %#v", src, want, findings)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package rules
+
+/*
+ * file: internal/rules/DL3078.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"strings"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// noInsecureRun flags RUN instructions executed without the build sandbox.
+type noInsecureRun struct{}
+
+// NewNoInsecureRun constructs the rule.
+func NewNoInsecureRun() engine.Rule { return noInsecureRun{} }
+
+// ID returns the rule identifier.
+func (noInsecureRun) ID() string { return "DL3078" }
+
+// Metadata describes the rule.
+func (noInsecureRun) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Do not use RUN --security=insecure",
+		Description: "`RUN --security=insecure` runs the command as a privileged container with full access to the build host's devices and kernel. It requires the `security.insecure` entitlement and should not appear in regular builds.",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"run", "privileged"},
+		Severity:    engine.SeverityError,
+		DocsURL:     docsURL("DL3078"),
+	}
+}
+
+// Check reports RUN instructions with --security=insecure.
+func (noInsecureRun) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	for _, n := range d.Instructions("run") {
+		for _, f := range n.Flags {
+			if strings.EqualFold(f, "--security=insecure") {
+				findings = append(findings, engine.Finding{
+					RuleID:  "DL3078",
+					Message: "RUN --security=insecure runs the command as a privileged container",
+					Line:    n.StartLine,
+				})
+				break
+			}
+		}
+	}
+	return findings, nil
+}
@@ -0,0 +1,27 @@
+// file: internal/rules/DL3078_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import "testing"
+
+// TestIntegrationNoInsecureRunID validates rule identity.
+func TestIntegrationNoInsecureRunID(t *testing.T) {
+	if NewNoInsecureRun().ID() != "DL3078" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationNoInsecureRun reports RUN instructions outside the sandbox.
+func TestIntegrationNoInsecureRun(t *testing.T) {
+	cases := map[string]int{
+		"FROM alpine:3.19\nRUN --security=insecure mount -t tmpfs none /mnt\n":                    1,
+		"FROM alpine:3.19\nRUN --mount=type=cache,target=/root/.cache --security=insecure make\n": 1,
+		"FROM alpine:3.19\nRUN --security=sandbox make\n":                                         0,
+		"FROM alpine:3.19\nRUN make\n":                                                            0,
+	}
+	for src, want := range cases {
+		if findings := checkRule(t, NewNoInsecureRun(), src); len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %#v", src, want, findings)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package rules
+
+/*
+ * file: internal/rules/DL3079.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// validExposeSyntax validates the port specifications of EXPOSE.
+type validExposeSyntax struct{}
+
+// NewValidExposeSyntax constructs the rule.
+func NewValidExposeSyntax() engine.Rule { return validExposeSyntax{} }
+
+// ID returns the rule identifier.
+func (validExposeSyntax) ID() string { return "DL3079" }
+
+// Metadata describes the rule.
+func (validExposeSyntax) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "EXPOSE ports must be port[-port][/tcp|udp|sctp]",
+		Description: "`EXPOSE` accepts a port or an ascending port range, optionally followed by `/tcp`, `/udp` or `/sctp`. Names, unknown protocols, reversed ranges and host mappings such as `8080:80` fail the build or are not what they seem.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"expose", "ports"},
+		Severity:    engine.SeverityError,
+		DocsURL:     docsURL("DL3079"),
+	}
+}
+
+// Check parses each port of every EXPOSE instruction after substituting
+// build variables with known values.
+func (validExposeSyntax) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	for _, n := range d.Instructions("expose") {
+		for _, port := range exposedPorts(d, n) {
+			if _, err := parsePortSpec(port); err != nil {
+				findings = append(findings, engine.Finding{
+					RuleID:  "DL3079",
+					Message: "EXPOSE " + err.Error(),
+					Line:    n.StartLine,
+				})
+			}
+		}
+	}
+	return findings, nil
+}
@@ -0,0 +1,34 @@
+// file: internal/rules/DL3079_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import "testing"
+
+// TestIntegrationValidExposeSyntaxID validates rule identity.
+func TestIntegrationValidExposeSyntaxID(t *testing.T) {
+	if NewValidExposeSyntax().ID() != "DL3079" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationValidExposeSyntax reports malformed port specifications.
+func TestIntegrationValidExposeSyntax(t *testing.T) {
+	cases := map[string]int{
+		"FROM alpine:3.19\nEXPOSE http\n":                         1,
+		"FROM alpine:3.19\nEXPOSE 80/icmp\n":                      1,
+		"FROM alpine:3.19\nEXPOSE 8010-8000\n":                    1,
+		"FROM alpine:3.19\nEXPOSE 8080:80\n":                      1,
+		"FROM alpine:3.19\nEXPOSE 80/\n":                          1,
+		"FROM alpine:3.19\nARG PORT=web\nEXPOSE $PORT\n":          1,
+		"FROM alpine:3.19\nEXPOSE 80 http 443/htp\n":              2,
+		"FROM alpine:3.19\nEXPOSE 80 443/tcp 53/udp 2905/sctp\n":  0,
+		"FROM alpine:3.19\nEXPOSE 8000-8010/UDP\n":                0,
+		"FROM alpine:3.19\nENV PORTS=\"80 443\"\nEXPOSE $PORTS\n": 0,
+		"FROM alpine:3.19\nARG PORT\nEXPOSE $PORT\n":              0,
+	}
+	for src, want := range cases {
+		if findings := checkRule(t, NewValidExposeSyntax(), src); len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %#v", src, want, findings)
+		}
+	}
+}
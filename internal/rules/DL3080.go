@@ -0,0 +1,67 @@
+package rules
+
+/*
+ * file: internal/rules/DL3080.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// firstUnprivilegedPort is the lowest port a process without
+// CAP_NET_BIND_SERVICE may bind by default.
+const firstUnprivilegedPort = 1024
+
+// privilegedPortNonRoot flags privileged ports exposed by images that run as a non-root user.
+type privilegedPortNonRoot struct{}
+
+// NewPrivilegedPortNonRoot constructs the rule.
+func NewPrivilegedPortNonRoot() engine.Rule { return privilegedPortNonRoot{} }
+
+// ID returns the rule identifier.
+func (privilegedPortNonRoot) ID() string { return "DL3080" }
+
+// Metadata describes the rule.
+func (privilegedPortNonRoot) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Non-root images should not expose privileged ports",
+		Description: "A process running as a non-root user cannot bind ports below 1024 without `CAP_NET_BIND_SERVICE` or a lowered `net.ipv4.ip_unprivileged_port_start`. Listen on a port from 1024 upwards, such as 8080, and map it when publishing.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"expose", "ports", "user"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3080"),
+	}
+}
+
+// Check reports ports below 1024 exposed by the target stage when its
+// effective USER, following FROM <stage> inheritance, is known and not root.
+func (privilegedPortNonRoot) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	users := stageUsers(d, d.TargetStage())
+	if len(users) == 0 {
+		return findings, nil
+	}
+	user := users[len(users)-1].User
+	if isRootUser(user) || strings.Contains(user, "$") {
+		return findings, nil
+	}
+	for _, e := range targetExposures(d) {
+		if e.Port.Start < firstUnprivilegedPort {
+			findings = append(findings, engine.Finding{
+				RuleID:  "DL3080",
+				Message: fmt.Sprintf("Port %s is privileged but the image runs as %s; listen on a port from %d upwards", e.Port, user, firstUnprivilegedPort),
+				Line:    e.Node.StartLine,
+			})
+		}
+	}
+	return findings, nil
+}
@@ -0,0 +1,34 @@
+// file: internal/rules/DL3080_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import "testing"
+
+// TestIntegrationPrivilegedPortNonRootID validates rule identity.
+func TestIntegrationPrivilegedPortNonRootID(t *testing.T) {
+	if NewPrivilegedPortNonRoot().ID() != "DL3080" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationPrivilegedPortNonRoot reports ports below 1024 for non-root images.
+func TestIntegrationPrivilegedPortNonRoot(t *testing.T) {
+	cases := map[string]int{
+		"FROM alpine:3.19\nEXPOSE 80\nUSER 10001\n":                                    1,
+		"FROM alpine:3.19\nEXPOSE 80 443 8080\nUSER app\n":                             2,
+		"FROM alpine:3.19\nEXPOSE 1000-1100\nUSER app\n":                               1,
+		"FROM alpine:3.19 AS base\nEXPOSE 80\nFROM base\nUSER app\n":                   1,
+		"FROM alpine:3.19\nARG PORT=443\nEXPOSE $PORT\nUSER app\n":                     1,
+		"FROM alpine:3.19\nEXPOSE 8080\nUSER app\n":                                    0,
+		"FROM alpine:3.19\nEXPOSE 80\n":                                                0,
+		"FROM alpine:3.19\nEXPOSE 80\nUSER root\n":                                     0,
+		"FROM alpine:3.19\nEXPOSE 80\nUSER app\nUSER 0\n":                              0,
+		"FROM alpine:3.19\nARG RUNTIME_USER\nEXPOSE 80\nUSER $RUNTIME_USER\n":          0,
+		"FROM alpine:3.19 AS build\nEXPOSE 80\nUSER app\nFROM alpine:3.19\nUSER app\n": 0,
+	}
+	for src, want := range cases {
+		if findings := checkRule(t, NewPrivilegedPortNonRoot(), src); len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %#v", src, want, findings)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package rules
+
+/*
+ * file: internal/rules/DL3081.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// managementPorts maps well-known remote administration ports to their service.
+var managementPorts = []struct {
+	port    int
+	service string
+}{
+	{22, "SSH"},
+	{2375, "the unencrypted Docker daemon API"},
+	{2376, "the Docker daemon API"},
+	{3389, "RDP"},
+}
+
+// noManagementPort flags exposed remote administration ports.
+type noManagementPort struct{}
+
+// NewNoManagementPort constructs the rule.
+func NewNoManagementPort() engine.Rule { return noManagementPort{} }
+
+// ID returns the rule identifier.
+func (noManagementPort) ID() string { return "DL3081" }
+
+// Metadata describes the rule.
+func (noManagementPort) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Do not expose remote administration ports",
+		Description: "Exposing SSH (22), the Docker daemon API (2375/2376) or RDP (3389) invites remote logins into the container or control of its host. Use `docker exec` or an orchestrator for administration.",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"expose", "ports", "ssh"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3081"),
+	}
+}
+
+// Check reports TCP ports and ranges of the target stage that include a
+// management port.
+func (noManagementPort) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	for _, e := range targetExposures(d) {
+		if e.Port.Proto != "tcp" {
+			continue
+		}
+		for _, m := range managementPorts {
+			if m.port >= e.Port.Start && m.port <= e.Port.End {
+				findings = append(findings, engine.Finding{
+					RuleID:  "DL3081",
+					Message: fmt.Sprintf("EXPOSE %s publishes port %d used by %s", e.Port, m.port, m.service),
+					Line:    e.Node.StartLine,
+				})
+			}
+		}
+	}
+	return findings, nil
+}
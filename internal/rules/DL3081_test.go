@@ -0,0 +1,31 @@
+// file: internal/rules/DL3081_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import "testing"
+
+// TestIntegrationNoManagementPortID validates rule identity.
+func TestIntegrationNoManagementPortID(t *testing.T) {
+	if NewNoManagementPort().ID() != "DL3081" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationNoManagementPort reports exposed remote administration ports.
+func TestIntegrationNoManagementPort(t *testing.T) {
+	cases := map[string]int{
+		"FROM alpine:3.19\nEXPOSE 22\n":                                       1,
+		"FROM alpine:3.19\nEXPOSE 2375/tcp 2376\n":                            2,
+		"FROM alpine:3.19\nEXPOSE 3000-4000\n":                                1,
+		"FROM alpine:3.19\nARG SSH_PORT=22\nEXPOSE $SSH_PORT\n":               1,
+		"FROM alpine:3.19 AS base\nEXPOSE 3389\nFROM base\n":                  1,
+		"FROM alpine:3.19\nEXPOSE 22/udp\n":                                   0,
+		"FROM alpine:3.19\nEXPOSE 80 443 8080\n":                              0,
+		"FROM alpine:3.19 AS build\nEXPOSE 22\nFROM alpine:3.19\nEXPOSE 80\n": 0,
+	}
+	for src, want := range cases {
+		if findings := checkRule(t, NewNoManagementPort(), src); len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %#v", src, want, findings)
+		}
+	}
+}
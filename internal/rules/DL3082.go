@@ -0,0 +1,119 @@
+package rules
+
+/*
+ * file: internal/rules/DL3082.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// portFilter is a configured port or range, matching every protocol unless
+// the entry names one.
+type portFilter struct {
+	spec     portSpec
+	anyProto bool
+}
+
+// parsePortFilters parses the port entries configured under key, failing on
+// the first invalid one.
+func parsePortFilters(key string, entries []string) ([]portFilter, error) {
+	var out []portFilter
+	for _, e := range entries {
+		e = strings.TrimSpace(e)
+		spec, err := parsePortSpec(e)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+		out = append(out, portFilter{spec: spec, anyProto: !strings.Contains(e, "/")})
+	}
+	return out, nil
+}
+
+// contains reports whether p lies entirely within the filter.
+func (f portFilter) contains(p portSpec) bool {
+	return (f.anyProto || f.spec.Proto == p.Proto) && f.spec.Start <= p.Start && p.End <= f.spec.End
+}
+
+// overlaps reports whether p shares a port with the filter.
+func (f portFilter) overlaps(p portSpec) bool {
+	return (f.anyProto || f.spec.Proto == p.Proto) && f.spec.Start <= p.End && p.Start <= f.spec.End
+}
+
+// exposePolicy restricts exposed ports to configured allow and deny lists.
+type exposePolicy struct {
+	allowed []portFilter
+	denied  []portFilter
+}
+
+// NewExposePolicy constructs the rule from the allowed-ports and denied-ports
+// configuration entries, such as 8080, 8000-8099 or 53/udp. It fails when an
+// entry is not a valid port, range or protocol.
+func NewExposePolicy(allowed, denied []string) (engine.Rule, error) {
+	a, err := parsePortFilters("allowed-ports", allowed)
+	if err != nil {
+		return nil, err
+	}
+	d, err := parsePortFilters("denied-ports", denied)
+	if err != nil {
+		return nil, err
+	}
+	return &exposePolicy{allowed: a, denied: d}, nil
+}
+
+// ID returns the rule identifier.
+func (exposePolicy) ID() string { return "DL3082" }
+
+// Metadata describes the rule.
+func (exposePolicy) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Restrict ports exposed by the image",
+		Description: "Ports exposed by the final image must fall within the configured `allowed-ports` and outside the configured `denied-ports`. Without configuration the rule reports nothing.",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"expose", "ports", "policy"},
+		Severity:    engine.SeverityError,
+		DocsURL:     docsURL("DL3082"),
+	}
+}
+
+// Check reports ports of the target stage that overlap a denied entry or,
+// when an allow list is configured, lie outside every allowed entry.
+func (r *exposePolicy) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil || (len(r.allowed) == 0 && len(r.denied) == 0) {
+		return findings, nil
+	}
+	report := func(msg string, line int) {
+		findings = append(findings, engine.Finding{RuleID: "DL3082", Message: msg, Line: line})
+	}
+	for _, e := range targetExposures(d) {
+		denied := false
+		for _, f := range r.denied {
+			if f.overlaps(e.Port) {
+				report(fmt.Sprintf("EXPOSE %s includes ports denied by the configuration", e.Port), e.Node.StartLine)
+				denied = true
+				break
+			}
+		}
+		if denied || len(r.allowed) == 0 {
+			continue
+		}
+		allowed := false
+		for _, f := range r.allowed {
+			if f.contains(e.Port) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			report(fmt.Sprintf("EXPOSE %s is not among the allowed ports", e.Port), e.Node.StartLine)
+		}
+	}
+	return findings, nil
+}
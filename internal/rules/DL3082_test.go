@@ -0,0 +1,91 @@
+// file: internal/rules/DL3082_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/asymmetric-effort/docker-lint/internal/config"
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+)
+
+// exposePolicyRule returns the rule for valid allow and deny lists.
+func exposePolicyRule(t *testing.T, allowed, denied []string) engine.Rule {
+	t.Helper()
+	r, err := NewExposePolicy(allowed, denied)
+	if err != nil {
+		t.Fatalf("NewExposePolicy: %v", err)
+	}
+	return r
+}
+
+// TestIntegrationExposePolicyID validates rule identity.
+func TestIntegrationExposePolicyID(t *testing.T) {
+	if exposePolicyRule(t, nil, nil).ID() != "DL3082" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationExposePolicyUnconfigured reports nothing without allow or deny lists.
+func TestIntegrationExposePolicyUnconfigured(t *testing.T) {
+	if findings := checkRule(t, exposePolicyRule(t, nil, nil), "FROM alpine:3.19\nEXPOSE 22 80\n"); len(findings) != 0 {
+		t.Fatalf("expected no findings, got %#v", findings)
+	}
+}
+
+// TestIntegrationExposePolicy applies the configured allow and deny lists.
+func TestIntegrationExposePolicy(t *testing.T) {
+	r := exposePolicyRule(t, []string{"8000-8099", "443", "53/udp"}, []string{"8080", "9000-9100/udp"})
+	cases := map[string]int{
+		"FROM alpine:3.19\nEXPOSE 8000 443/tcp 53/udp\n":   0,
+		"FROM alpine:3.19\nEXPOSE 8010-8020\n":             0,
+		"FROM alpine:3.19\nEXPOSE 8080\n":                  1,
+		"FROM alpine:3.19\nEXPOSE 8070-8090\n":             1,
+		"FROM alpine:3.19\nEXPOSE 53\n":                    1,
+		"FROM alpine:3.19\nEXPOSE 80 8100\n":               2,
+		"FROM alpine:3.19\nEXPOSE 8050-8150\n":             1,
+		"FROM alpine:3.19\nARG PORT=22\nEXPOSE $PORT\n":    1,
+		"FROM alpine:3.19 AS base\nEXPOSE 22\nFROM base\n": 1,
+	}
+	for src, want := range cases {
+		if findings := checkRule(t, r, src); len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %#v", src, want, findings)
+		}
+	}
+}
+
+// TestIntegrationExposePolicyDenyOnly reports denied ports and allows the rest.
+func TestIntegrationExposePolicyDenyOnly(t *testing.T) {
+	r := exposePolicyRule(t, nil, []string{"9000-9100/udp"})
+	cases := map[string]int{
+		"FROM alpine:3.19\nEXPOSE 9050/udp\n": 1,
+		"FROM alpine:3.19\nEXPOSE 9050\n":     0,
+		"FROM alpine:3.19\nEXPOSE 22 80\n":    0,
+	}
+	for src, want := range cases {
+		if findings := checkRule(t, r, src); len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %#v", src, want, findings)
+		}
+	}
+}
+
+// TestIntegrationExposePolicyInvalid rejects malformed entries, naming the list.
+func TestIntegrationExposePolicyInvalid(t *testing.T) {
+	cases := []struct {
+		allowed, denied []string
+		want            string
+	}{
+		{[]string{"bogus"}, nil, `allowed-ports: "bogus" is not a port number or range`},
+		{[]string{"80"}, []string{"2375/tpc"}, `denied-ports: "2375/tpc" has unknown protocol "tpc"`},
+		{nil, []string{"22a"}, `denied-ports: "22a" is not a port number or range`},
+	}
+	for _, c := range cases {
+		if _, err := NewExposePolicy(c.allowed, c.denied); err == nil || !strings.Contains(err.Error(), c.want) {
+			t.Fatalf("%v %v: expected %q, got %v", c.allowed, c.denied, c.want, err)
+		}
+	}
+	if _, err := Catalog(&config.Config{DeniedPorts: []string{"22a"}}); err == nil || !strings.Contains(err.Error(), "denied-ports") {
+		t.Fatalf("expected catalog error, got %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package rules
+
+/*
+ * file: internal/rules/DL3083.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// healthcheckDurationFlags lists the HEALTHCHECK options that take a duration.
+var healthcheckDurationFlags = map[string]struct{}{
+	"interval": {}, "timeout": {}, "start-period": {}, "start-interval": {},
+}
+
+// defaultHealthcheckDuration is the interval and timeout Docker applies when
+// the option is omitted or zero.
+const defaultHealthcheckDuration = 30 * time.Second
+
+// validHealthcheckOptions validates the options of HEALTHCHECK instructions.
+type validHealthcheckOptions struct{}
+
+// NewValidHealthcheckOptions constructs the rule.
+func NewValidHealthcheckOptions() engine.Rule { return validHealthcheckOptions{} }
+
+// ID returns the rule identifier.
+func (validHealthcheckOptions) ID() string { return "DL3083" }
+
+// Metadata describes the rule.
+func (validHealthcheckOptions) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "HEALTHCHECK options must be valid",
+		Description: "`--interval`, `--timeout`, `--start-period` and `--start-interval` take durations with a unit, such as `30s`, of at least 1ms, and `--retries` takes a non-negative integer. Unknown options, options on `HEALTHCHECK NONE` and a timeout longer than the interval are also reported.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"healthcheck"},
+		Severity:    engine.SeverityError,
+		DocsURL:     docsURL("DL3083"),
+	}
+}
+
+// Check validates the flags of every HEALTHCHECK instruction.
+func (validHealthcheckOptions) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	for _, n := range d.Instructions("healthcheck") {
+		report := func(msg string) {
+			findings = append(findings, engine.Finding{RuleID: "DL3083", Message: msg, Line: n.StartLine})
+		}
+		if isHealthcheckNone(n) && len(n.Flags) > 0 {
+			report("HEALTHCHECK NONE ignores its options")
+			continue
+		}
+		interval, timeout := defaultHealthcheckDuration, defaultHealthcheckDuration
+		for _, f := range n.Flags {
+			name, value, _ := strings.Cut(strings.TrimPrefix(f, "--"), "=")
+			name = strings.ToLower(name)
+			if name == "retries" {
+				if r, err := strconv.Atoi(value); err != nil || r < 0 {
+					report(fmt.Sprintf("HEALTHCHECK --retries=%s must be a non-negative integer", value))
+				}
+				continue
+			}
+			if _, ok := healthcheckDurationFlags[name]; !ok {
+				report(fmt.Sprintf("HEALTHCHECK has unknown option --%s", name))
+				continue
+			}
+			v, err := time.ParseDuration(value)
+			switch {
+			case err != nil:
+				report(fmt.Sprintf("HEALTHCHECK --%s=%s is not a duration; use a unit such as 30s", name, value))
+			case v < 0:
+				report(fmt.Sprintf("HEALTHCHECK --%s=%s cannot be negative", name, value))
+			case v > 0 && v < time.Millisecond:
+				report(fmt.Sprintf("HEALTHCHECK --%s=%s cannot be less than 1ms", name, value))
+			case v > 0 && name == "interval":
+				interval = v
+			case v > 0 && name == "timeout":
+				timeout = v
+			}
+		}
+		if timeout > interval {
+			report(fmt.Sprintf("HEALTHCHECK timeout %s exceeds the interval %s between checks", timeout, interval))
+		}
+	}
+	return findings, nil
+}
@@ -0,0 +1,39 @@
+// file: internal/rules/DL3083_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import "testing"
+
+// TestIntegrationValidHealthcheckOptionsID validates rule identity.
+func TestIntegrationValidHealthcheckOptionsID(t *testing.T) {
+	if NewValidHealthcheckOptions().ID() != "DL3083" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationValidHealthcheckOptions reports invalid HEALTHCHECK options.
+func TestIntegrationValidHealthcheckOptions(t *testing.T) {
+	cases := map[string]int{
+		"FROM alpine:3.19\nHEALTHCHECK --interval=30 CMD true\n":                           1,
+		"FROM alpine:3.19\nHEALTHCHECK --timeout=-5s CMD true\n":                           1,
+		"FROM alpine:3.19\nHEALTHCHECK --start-period=100us CMD true\n":                    1,
+		"FROM alpine:3.19\nHEALTHCHECK --start-interval=soon CMD true\n":                   1,
+		"FROM alpine:3.19\nHEALTHCHECK --retries=-1 CMD true\n":                            1,
+		"FROM alpine:3.19\nHEALTHCHECK --retries=three CMD true\n":                         1,
+		"FROM alpine:3.19\nHEALTHCHECK --period=5s CMD true\n":                             1,
+		"FROM alpine:3.19\nHEALTHCHECK --interval=5s CMD true\n":                           1,
+		"FROM alpine:3.19\nHEALTHCHECK --interval=10s --timeout=1m CMD true\n":             1,
+		"FROM alpine:3.19\nHEALTHCHECK --interval=5s NONE\n":                               1,
+		"FROM alpine:3.19\nHEALTHCHECK --interval=1 --retries=x CMD true\n":                2,
+		"FROM alpine:3.19\nHEALTHCHECK --interval=1m --timeout=10s --retries=3 CMD true\n": 0,
+		"FROM alpine:3.19\nHEALTHCHECK --start-period=1m --start-interval=2s CMD true\n":   0,
+		"FROM alpine:3.19\nHEALTHCHECK --interval=0s --retries=0 CMD true\n":               0,
+		"FROM alpine:3.19\nHEALTHCHECK CMD true\n":                                         0,
+		"FROM alpine:3.19\nHEALTHCHECK NONE\n":                                             0,
+	}
+	for src, want := range cases {
+		if findings := checkRule(t, NewValidHealthcheckOptions(), src); len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %#v", src, want, findings)
+		}
+	}
+}
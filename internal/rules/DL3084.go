@@ -0,0 +1,69 @@
+package rules
+
+/*
+ * file: internal/rules/DL3084.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"path"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// healthcheckToolInstalled flags HEALTHCHECK commands using curl or wget in
+// images that do not contain them.
+type healthcheckToolInstalled struct{}
+
+// NewHealthcheckToolInstalled constructs the rule.
+func NewHealthcheckToolInstalled() engine.Rule { return healthcheckToolInstalled{} }
+
+// ID returns the rule identifier.
+func (healthcheckToolInstalled) ID() string { return "DL3084" }
+
+// Metadata describes the rule.
+func (healthcheckToolInstalled) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "HEALTHCHECK uses curl or wget without installing it",
+		Description: "Minimal base images such as `debian`, `ubuntu`, `*-slim`, distroless and `scratch` ship without `curl` and `wget`, and Alpine ships without `curl`. A health check that calls a missing binary always fails and marks the container unhealthy.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"healthcheck", "curl", "wget"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3084"),
+	}
+}
+
+// Check reports curl and wget calls in HEALTHCHECK instructions whose stage
+// lineage starts from an image known to lack the tool and never installs it.
+func (healthcheckToolInstalled) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	for _, n := range d.Instructions("healthcheck") {
+		st := d.StageOf(n)
+		if st == nil {
+			continue
+		}
+		image := baseImage(d, st)
+		reported := map[string]struct{}{}
+		for _, seg := range runSegments(d, n) {
+			name := path.Base(seg.Args[0])
+			if name != "curl" && name != "wget" {
+				continue
+			}
+			if _, ok := reported[name]; ok || !baseLacks(image, name) || installsCommand(d, stageLineage(d, st), name) {
+				continue
+			}
+			reported[name] = struct{}{}
+			findings = append(findings, engine.Finding{
+				RuleID:  "DL3084",
+				Message: "HEALTHCHECK runs " + name + ", which " + image + " does not provide and the stage does not install",
+				Line:    n.StartLine,
+			})
+		}
+	}
+	return findings, nil
+}
@@ -0,0 +1,35 @@
+// file: internal/rules/DL3084_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import "testing"
+
+// TestIntegrationHealthcheckToolInstalledID validates rule identity.
+func TestIntegrationHealthcheckToolInstalledID(t *testing.T) {
+	if NewHealthcheckToolInstalled().ID() != "DL3084" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationHealthcheckToolInstalled reports curl and wget missing from the stage.
+func TestIntegrationHealthcheckToolInstalled(t *testing.T) {
+	cases := map[string]int{
+		"FROM debian:12-slim\nHEALTHCHECK CMD curl -f http://localhost/ || exit 1\n":                                                      1,
+		"FROM python:3.12-slim\nHEALTHCHECK CMD [\"wget\", \"-q\", \"--spider\", \"http://localhost/\"]\n":                                1,
+		"FROM alpine:3.19\nHEALTHCHECK CMD curl -f http://localhost/\n":                                                                   1,
+		"FROM gcr.io/distroless/static-debian12\nHEALTHCHECK CMD [\"/usr/bin/curl\", \"http://localhost/\"]\n":                            1,
+		"FROM ubuntu:24.04 AS base\nFROM base\nHEALTHCHECK CMD curl -f localhost || wget -q localhost\n":                                  2,
+		"FROM alpine:3.19\nHEALTHCHECK CMD wget -q --spider http://localhost/\n":                                                          0,
+		"FROM alpine:3.19\nRUN apk add --no-cache curl=8.5.0-r0\nHEALTHCHECK CMD curl -f http://localhost/\n":                             0,
+		"FROM debian:12-slim\nRUN apt-get update && apt-get install -y --no-install-recommends curl\nHEALTHCHECK CMD curl -f localhost\n": 0,
+		"FROM debian:12 AS base\nRUN apt-get install -y wget\nFROM base\nHEALTHCHECK CMD wget -q localhost\n":                             0,
+		"FROM scratch\nCOPY --from=tools /usr/bin/curl /usr/bin/curl\nHEALTHCHECK CMD [\"/usr/bin/curl\", \"localhost\"]\n":               0,
+		"FROM node:20\nHEALTHCHECK CMD curl -f http://localhost/\n":                                                                       0,
+		"FROM debian:12-slim\nHEALTHCHECK CMD [\"/app\", \"healthcheck\"]\n":                                                              0,
+	}
+	for src, want := range cases {
+		if findings := checkRule(t, NewHealthcheckToolInstalled(), src); len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %#v", src, want, findings)
+		}
+	}
+}
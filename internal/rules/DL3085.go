@@ -0,0 +1,75 @@
+package rules
+
+/*
+ * file: internal/rules/DL3085.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"strings"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// healthcheckExecForm flags shell-form HEALTHCHECK instructions in images without a shell.
+type healthcheckExecForm struct{}
+
+// NewHealthcheckExecForm constructs the rule.
+func NewHealthcheckExecForm() engine.Rule { return healthcheckExecForm{} }
+
+// ID returns the rule identifier.
+func (healthcheckExecForm) ID() string { return "DL3085" }
+
+// Metadata describes the rule.
+func (healthcheckExecForm) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Use exec form for HEALTHCHECK in images without a shell",
+		Description: "A shell-form `HEALTHCHECK CMD` runs through `/bin/sh -c`, which `scratch`, distroless and similar images do not contain, so the check can never succeed. Use the JSON exec form, such as `HEALTHCHECK CMD [\"/app\", \"healthcheck\"]`.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"healthcheck", "distroless", "scratch"},
+		Severity:    engine.SeverityError,
+		DocsURL:     docsURL("DL3085"),
+	}
+}
+
+// Check reports shell-form HEALTHCHECK CMD instructions in stages whose
+// lineage starts from an image without /bin/sh and sets no SHELL.
+func (healthcheckExecForm) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	for _, n := range d.Instructions("healthcheck") {
+		if n.Attributes["json"] || isHealthcheckNone(n) {
+			continue
+		}
+		st := d.StageOf(n)
+		if st == nil {
+			continue
+		}
+		image := baseImage(d, st)
+		if !isShellless(image) || setsShell(d, st) {
+			continue
+		}
+		findings = append(findings, engine.Finding{
+			RuleID:  "DL3085",
+			Message: "Shell-form HEALTHCHECK needs /bin/sh, which " + image + " does not provide; use the exec form",
+			Line:    n.StartLine,
+		})
+	}
+	return findings, nil
+}
+
+// setsShell reports whether a stage of st's lineage has a SHELL instruction.
+func setsShell(d *ir.Document, st *ir.Stage) bool {
+	for _, s := range stageLineage(d, st) {
+		for _, n := range s.Instructions {
+			if strings.EqualFold(n.Value, "shell") {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,32 @@
+// file: internal/rules/DL3085_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import "testing"
+
+// TestIntegrationHealthcheckExecFormID validates rule identity.
+func TestIntegrationHealthcheckExecFormID(t *testing.T) {
+	if NewHealthcheckExecForm().ID() != "DL3085" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationHealthcheckExecForm reports shell-form health checks without a shell.
+func TestIntegrationHealthcheckExecForm(t *testing.T) {
+	cases := map[string]int{
+		"FROM scratch\nHEALTHCHECK CMD /app healthcheck\n":                                                                  1,
+		"FROM gcr.io/distroless/base-debian12:nonroot\nHEALTHCHECK CMD /app -health\n":                                      1,
+		"FROM cgr.dev/chainguard/static\nHEALTHCHECK CMD /app -health\n":                                                    1,
+		"ARG BASE=scratch\nFROM ${BASE} AS base\nFROM base\nHEALTHCHECK CMD /app -health\n":                                 1,
+		"FROM scratch\nHEALTHCHECK CMD [\"/app\", \"healthcheck\"]\n":                                                       0,
+		"FROM gcr.io/distroless/base-debian12:debug\nHEALTHCHECK CMD /app -health\n":                                        0,
+		"FROM scratch\nCOPY busybox /bin/busybox\nSHELL [\"/bin/busybox\", \"sh\", \"-c\"]\nHEALTHCHECK CMD /app -health\n": 0,
+		"FROM scratch\nHEALTHCHECK NONE\n":                                                                                  0,
+		"FROM alpine:3.19\nHEALTHCHECK CMD /app healthcheck\n":                                                              0,
+	}
+	for src, want := range cases {
+		if findings := checkRule(t, NewHealthcheckExecForm(), src); len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %#v", src, want, findings)
+		}
+	}
+}
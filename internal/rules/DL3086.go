@@ -0,0 +1,77 @@
+package rules
+
+/*
+ * file: internal/rules/DL3086.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"strings"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// ignoreSensitiveContext flags whole-context copies that a .dockerignore does not guard.
+type ignoreSensitiveContext struct{}
+
+// NewIgnoreSensitiveContext constructs the rule.
+func NewIgnoreSensitiveContext() engine.Rule { return ignoreSensitiveContext{} }
+
+// ID returns the rule identifier.
+func (ignoreSensitiveContext) ID() string { return "DL3086" }
+
+// Metadata describes the rule.
+func (ignoreSensitiveContext) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Exclude sensitive files from broad context copies",
+		Description: "`COPY . .` and `ADD . /app` copy the whole build context. Without a `.dockerignore` (or `<Dockerfile>.dockerignore`) excluding `.git`, `.env*`, `*.pem` and `node_modules`, repository history, credentials and host dependencies end up in the image.",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"copy", "add", "dockerignore", "secrets"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3086"),
+	}
+}
+
+// Check reports COPY and ADD instructions whose sources include the whole
+// build context when the ignore file is missing or lets sensitive paths
+// through. Documents without a build context are not checked.
+func (ignoreSensitiveContext) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil || d.Context == nil {
+		return findings, nil
+	}
+	var missing []string
+	for _, s := range contextSecrets {
+		for _, p := range s.samples {
+			if !d.Context.Ignore.Excludes(p) {
+				missing = append(missing, s.label)
+				break
+			}
+		}
+	}
+	if len(missing) == 0 {
+		return findings, nil
+	}
+	for _, n := range d.AST.Children {
+		kw := strings.ToLower(n.Value)
+		if kw != "copy" && kw != "add" {
+			continue
+		}
+		for _, src := range localSources(n) {
+			if !isWholeContext(src) {
+				continue
+			}
+			msg := strings.ToUpper(kw) + " " + src + " copies the entire build context"
+			if d.Context.Ignore == nil {
+				msg += " and no .dockerignore excludes " + strings.Join(missing, ", ")
+			} else {
+				msg += " but " + d.Context.Ignore.Path + " does not exclude " + strings.Join(missing, ", ")
+			}
+			findings = append(findings, engine.Finding{RuleID: "DL3086", Message: msg, Line: n.StartLine})
+			break
+		}
+	}
+	return findings, nil
+}
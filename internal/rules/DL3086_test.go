@@ -0,0 +1,108 @@
+// file: internal/rules/DL3086_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// checkContextRule writes files into a build context directory next to a
+// Dockerfile holding src, runs r against the document with that context and
+// returns the findings.
+func checkContextRule(t *testing.T, r engine.Rule, src string, files map[string]string) []engine.Finding {
+	t.Helper()
+	return lintContext(t, r, src, files, false)
+}
+
+// checkExplicitContextRule is checkContextRule with the context directory
+// given explicitly, as --context does.
+func checkExplicitContextRule(t *testing.T, r engine.Rule, src string, files map[string]string) []engine.Finding {
+	t.Helper()
+	return lintContext(t, r, src, files, true)
+}
+
+// lintContext runs r against src in a build context holding files, loading
+// the context as the Dockerfile's directory or, when explicit is set, as a
+// directory given by the user.
+func lintContext(t *testing.T, r engine.Rule, src string, files map[string]string, explicit bool) []engine.Finding {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	res, err := parser.Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	df := filepath.Join(dir, "Dockerfile")
+	doc, err := ir.BuildDocument(df, res.AST)
+	if err != nil {
+		t.Fatalf("build document: %v", err)
+	}
+	contextDir := ""
+	if explicit {
+		contextDir = dir
+	}
+	if doc.Context, err = ir.LoadContext(df, contextDir); err != nil {
+		t.Fatalf("load context: %v", err)
+	}
+	findings, err := r.Check(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("check failed: %v", err)
+	}
+	return findings
+}
+
+// TestIntegrationIgnoreSensitiveContextID validates rule identity.
+func TestIntegrationIgnoreSensitiveContextID(t *testing.T) {
+	if NewIgnoreSensitiveContext().ID() != "DL3086" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationIgnoreSensitiveContext reports broad copies the ignore file does not guard.
+func TestIntegrationIgnoreSensitiveContext(t *testing.T) {
+	complete := ".git\n.env*\n*.pem\nnode_modules\n"
+	cases := []struct {
+		src   string
+		files map[string]string
+		want  int
+	}{
+		{"FROM alpine:3.19\nCOPY . .\n", nil, 1},
+		{"FROM alpine:3.19\nADD . /app\n", map[string]string{".dockerignore": ".git\n"}, 1},
+		{"FROM alpine:3.19\nCOPY ./ /app/\nCOPY * /srv/\n", map[string]string{".dockerignore": ".git\n.env\n*.pem\nnode_modules\n"}, 2},
+		{"FROM alpine:3.19\nCOPY . .\n", map[string]string{".dockerignore": complete + "!.env.local\n"}, 1},
+		{"FROM alpine:3.19\nCOPY . .\n", map[string]string{".dockerignore": complete}, 0},
+		{"FROM alpine:3.19\nCOPY . .\n", map[string]string{".dockerignore": "**\n!src\n"}, 0},
+		{"FROM alpine:3.19\nCOPY . .\n", map[string]string{".dockerignore": ".git\n", "Dockerfile.dockerignore": complete}, 0},
+		{"FROM alpine:3.19\nCOPY . .\n", map[string]string{".dockerignore": complete, "Dockerfile.dockerignore": ".git\n"}, 1},
+		{"FROM alpine:3.19\nCOPY src/ /app/\nCOPY --from=build . /app\n", nil, 0},
+	}
+	for _, c := range cases {
+		if findings := checkContextRule(t, NewIgnoreSensitiveContext(), c.src, c.files); len(findings) != c.want {
+			t.Fatalf("%q with %v: expected %d findings, got %#v", c.src, c.files, c.want, findings)
+		}
+	}
+}
+
+// TestIntegrationIgnoreSensitiveContextNoContext skips documents without a build context.
+func TestIntegrationIgnoreSensitiveContextNoContext(t *testing.T) {
+	if findings := checkRule(t, NewIgnoreSensitiveContext(), "FROM alpine:3.19\nCOPY . .\n"); len(findings) != 0 {
+		t.Fatalf("expected no findings, got %#v", findings)
+	}
+}
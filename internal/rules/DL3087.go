@@ -0,0 +1,53 @@
+package rules
+
+/*
+ * file: internal/rules/DL3087.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// validIgnorePatterns flags .dockerignore patterns that never match.
+type validIgnorePatterns struct{}
+
+// NewValidIgnorePatterns constructs the rule.
+func NewValidIgnorePatterns() engine.Rule { return validIgnorePatterns{} }
+
+// ID returns the rule identifier.
+func (validIgnorePatterns) ID() string { return "DL3087" }
+
+// Metadata describes the rule.
+func (validIgnorePatterns) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       ".dockerignore patterns must be valid",
+		Description: "Patterns with invalid syntax such as an unclosed `[`, exceptions consisting of a lone `!` and patterns that point outside the context with `..` never match, so the files they were meant to exclude are sent to the builder.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"dockerignore"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3087"),
+	}
+}
+
+// Check reports each invalid pattern of the document's ignore file. The
+// findings have no Dockerfile line; the message names the ignore file line.
+func (validIgnorePatterns) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil || d.Context == nil || d.Context.Ignore == nil {
+		return findings, nil
+	}
+	for _, p := range d.Context.Ignore.Patterns {
+		if p.Err != nil {
+			findings = append(findings, engine.Finding{
+				RuleID:  "DL3087",
+				Message: fmt.Sprintf("%s:%d: pattern %q is ignored: %v", d.Context.Ignore.Path, p.Line, p.Text, p.Err),
+			})
+		}
+	}
+	return findings, nil
+}
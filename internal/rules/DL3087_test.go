@@ -0,0 +1,36 @@
+// file: internal/rules/DL3087_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestIntegrationValidIgnorePatternsID validates rule identity.
+func TestIntegrationValidIgnorePatternsID(t *testing.T) {
+	if NewValidIgnorePatterns().ID() != "DL3087" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationValidIgnorePatterns reports invalid .dockerignore patterns with their line.
+func TestIntegrationValidIgnorePatterns(t *testing.T) {
+	src := "FROM alpine:3.19\n"
+	ignore := "# comment\n.git\nsecrets/[abc\n!\n../shared\n*.pem\n"
+	findings := checkContextRule(t, NewValidIgnorePatterns(), src, map[string]string{".dockerignore": ignore})
+	if len(findings) != 3 {
+		t.Fatalf("expected 3 findings, got %#v", findings)
+	}
+	for i, line := range []string{":3:", ":4:", ":5:"} {
+		if !strings.Contains(findings[i].Message, ".dockerignore"+line) || findings[i].Line != 0 {
+			t.Fatalf("finding %d: unexpected %#v", i, findings[i])
+		}
+	}
+	if findings := checkContextRule(t, NewValidIgnorePatterns(), src, map[string]string{".dockerignore": ".git\n**/*.pem\n!keep.pem\n"}); len(findings) != 0 {
+		t.Fatalf("expected no findings, got %#v", findings)
+	}
+	if findings := checkContextRule(t, NewValidIgnorePatterns(), src, nil); len(findings) != 0 {
+		t.Fatalf("expected no findings without an ignore file, got %#v", findings)
+	}
+}
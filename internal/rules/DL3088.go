@@ -0,0 +1,67 @@
+package rules
+
+/*
+ * file: internal/rules/DL3088.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"strings"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// sourceInContext flags COPY and ADD sources that are missing from the build context.
+type sourceInContext struct{}
+
+// NewSourceInContext constructs the rule.
+func NewSourceInContext() engine.Rule { return sourceInContext{} }
+
+// ID returns the rule identifier.
+func (sourceInContext) ID() string { return "DL3088" }
+
+// Metadata describes the rule.
+func (sourceInContext) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "COPY and ADD sources must exist in the build context",
+		Description: "A local `COPY` or `ADD` source that matches nothing in the build context, or that climbs out of it with `..`, fails the build or copies nothing. Checked when the build context is given with `--context`.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"copy", "add", "context"},
+		Severity:    engine.SeverityError,
+		DocsURL:     docsURL("DL3088"),
+	}
+}
+
+// Check reports local COPY and ADD sources that refer outside the build
+// context or match no file in it. Only explicitly given contexts are checked.
+func (sourceInContext) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil || d.Context == nil || !d.Context.Explicit {
+		return findings, nil
+	}
+	for _, n := range d.AST.Children {
+		kw := strings.ToLower(n.Value)
+		if kw != "copy" && kw != "add" {
+			continue
+		}
+		for _, src := range localSources(n) {
+			rel, ok := contextPath(d, n, src)
+			if !ok {
+				continue
+			}
+			var msg string
+			switch {
+			case isOutsideContext(rel):
+				msg = strings.ToUpper(kw) + " source " + src + " refers outside the build context"
+			case len(contextMatches(d.Context, rel)) == 0:
+				msg = strings.ToUpper(kw) + " source " + src + " does not exist in build context " + d.Context.Dir
+			default:
+				continue
+			}
+			findings = append(findings, engine.Finding{RuleID: "DL3088", Message: msg, Line: n.StartLine})
+		}
+	}
+	return findings, nil
+}
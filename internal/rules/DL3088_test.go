@@ -0,0 +1,43 @@
+// file: internal/rules/DL3088_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import "testing"
+
+// TestIntegrationSourceInContextID validates rule identity.
+func TestIntegrationSourceInContextID(t *testing.T) {
+	if NewSourceInContext().ID() != "DL3088" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationSourceInContext reports sources missing from an explicit build context.
+func TestIntegrationSourceInContext(t *testing.T) {
+	files := map[string]string{"app/main.go": "package main\n", "go.mod": "module x\n", "conf/a.yaml": "a: 1\n"}
+	cases := map[string]int{
+		"FROM golang:1.24\nCOPY go.mod app/ /src/\n":                                        0,
+		"FROM golang:1.24\nCOPY conf/*.yaml /etc/app/\n":                                    0,
+		"FROM golang:1.24\nARG DIR=app\nCOPY ${DIR} /src/\n":                                0,
+		"FROM golang:1.24\nCOPY /go.mod /src/\n":                                            0,
+		"FROM golang:1.24\nCOPY go.sum /src/\n":                                             1,
+		"FROM golang:1.24\nADD conf/*.json go.work /etc/app/\n":                             2,
+		"FROM golang:1.24\nCOPY ../secrets /src/\n":                                         1,
+		"FROM golang:1.24\nARG DIR\nCOPY ${DIR} /src/\n":                                    0,
+		"FROM golang:1.24\nCOPY --from=build /out /out\nADD https://example.com/x.tgz /x\n": 0,
+	}
+	for src, want := range cases {
+		if findings := checkExplicitContextRule(t, NewSourceInContext(), src, files); len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %#v", src, want, findings)
+		}
+	}
+}
+
+// TestIntegrationSourceInContextImplicit skips contexts that were not given explicitly.
+func TestIntegrationSourceInContextImplicit(t *testing.T) {
+	if findings := checkContextRule(t, NewSourceInContext(), "FROM alpine:3.19\nCOPY missing /x\n", nil); len(findings) != 0 {
+		t.Fatalf("expected no findings, got %#v", findings)
+	}
+	if findings := checkRule(t, NewSourceInContext(), "FROM alpine:3.19\nCOPY missing /x\n"); len(findings) != 0 {
+		t.Fatalf("expected no findings, got %#v", findings)
+	}
+}
@@ -0,0 +1,78 @@
+package rules
+
+/*
+ * file: internal/rules/DL3089.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// sourceNotIgnored flags COPY and ADD sources that .dockerignore removes from the build context.
+type sourceNotIgnored struct{}
+
+// NewSourceNotIgnored constructs the rule.
+func NewSourceNotIgnored() engine.Rule { return sourceNotIgnored{} }
+
+// ID returns the rule identifier.
+func (sourceNotIgnored) ID() string { return "DL3089" }
+
+// Metadata describes the rule.
+func (sourceNotIgnored) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "COPY and ADD sources must not be excluded by .dockerignore",
+		Description: "Files excluded by `.dockerignore` are never sent to the builder, so a `COPY` or `ADD` naming them fails with a file-not-found error although the file is right there in the working tree. Checked when the build context is given with `--context`.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"copy", "add", "context", "dockerignore"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL3089"),
+	}
+}
+
+// Check reports local COPY and ADD sources whose every match on disk is
+// excluded by the ignore file. Whole-context sources are left to DL3086, and
+// only explicitly given contexts are checked.
+func (sourceNotIgnored) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil || d.Context == nil || !d.Context.Explicit || d.Context.Ignore == nil {
+		return findings, nil
+	}
+	for _, n := range d.AST.Children {
+		kw := strings.ToLower(n.Value)
+		if kw != "copy" && kw != "add" {
+			continue
+		}
+		for _, src := range localSources(n) {
+			rel, ok := contextPath(d, n, src)
+			if !ok || isOutsideContext(rel) || isWholeContext(rel) {
+				continue
+			}
+			matches := contextMatches(d.Context, rel)
+			if len(matches) == 0 || includesAny(d.Context, matches) {
+				continue
+			}
+			msg := fmt.Sprintf("%s source %s is excluded from the build context by %s", strings.ToUpper(kw), src, d.Context.Ignore.Path)
+			if p := excludingPattern(d.Context.Ignore, matches[0]); p != nil {
+				msg += fmt.Sprintf(" (pattern %q on line %d)", p.Text, p.Line)
+			}
+			findings = append(findings, engine.Finding{RuleID: "DL3089", Message: msg, Line: n.StartLine})
+		}
+	}
+	return findings, nil
+}
+
+// includesAny reports whether one of the context-relative paths reaches the build context.
+func includesAny(c *ir.Context, paths []string) bool {
+	for _, p := range paths {
+		if contextIncludes(c, p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+// file: internal/rules/DL3089_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestIntegrationSourceNotIgnoredID validates rule identity.
+func TestIntegrationSourceNotIgnoredID(t *testing.T) {
+	if NewSourceNotIgnored().ID() != "DL3089" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationSourceNotIgnored reports sources removed from the context by .dockerignore.
+func TestIntegrationSourceNotIgnored(t *testing.T) {
+	files := map[string]string{
+		"app/main.go": "package main\n", "app/testdata/x": "x\n", "config/app.env": "A=1\n",
+		"docs/a.md": "a\n", "docs/keep.md": "k\n",
+	}
+	cases := []struct {
+		src    string
+		ignore string
+		want   int
+	}{
+		{"FROM alpine:3.19\nCOPY config/app.env /etc/\n", "*.env\n**/*.env\n", 1},
+		{"FROM alpine:3.19\nCOPY config /etc/config\n", "config\n", 1},
+		{"FROM alpine:3.19\nCOPY app/testdata /data\n", "app/testdata\n", 1},
+		{"FROM alpine:3.19\nCOPY app /src\n", "app/testdata\n", 0},
+		{"FROM alpine:3.19\nCOPY docs /docs\n", "docs\n!docs/keep.md\n", 0},
+		{"FROM alpine:3.19\nCOPY docs/*.md /docs/\n", "docs/a.md\n", 0},
+		{"FROM alpine:3.19\nCOPY config/app.env /etc/\n", "config\n!config/app.env\n", 0},
+		{"FROM alpine:3.19\nCOPY . /src\n", "**\n", 0},
+		{"FROM alpine:3.19\nCOPY missing /src\n", "missing\n", 0},
+	}
+	for _, c := range cases {
+		f := map[string]string{".dockerignore": c.ignore}
+		for k, v := range files {
+			f[k] = v
+		}
+		if findings := checkExplicitContextRule(t, NewSourceNotIgnored(), c.src, f); len(findings) != c.want {
+			t.Fatalf("%q with %q: expected %d findings, got %#v", c.src, c.ignore, c.want, findings)
+		}
+	}
+}
+
+// TestIntegrationSourceNotIgnoredMessage names the excluding pattern.
+func TestIntegrationSourceNotIgnoredMessage(t *testing.T) {
+	files := map[string]string{".dockerignore": "# build\n*.env\n", "app.env": "A=1\n"}
+	findings := checkExplicitContextRule(t, NewSourceNotIgnored(), "FROM alpine:3.19\nCOPY app.env /etc/\n", files)
+	if len(findings) != 1 || findings[0].Line != 2 || !strings.Contains(findings[0].Message, `pattern "*.env" on line 2`) {
+		t.Fatalf("unexpected findings %#v", findings)
+	}
+}
@@ -0,0 +1,100 @@
+package rules
+
+/*
+ * file: internal/rules/DL3090.go
+ * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+ */
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// noSensitiveCopy flags COPY and ADD instructions that put private keys or credentials into the image.
+type noSensitiveCopy struct{}
+
+// NewNoSensitiveCopy constructs the rule.
+func NewNoSensitiveCopy() engine.Rule { return noSensitiveCopy{} }
+
+// ID returns the rule identifier.
+func (noSensitiveCopy) ID() string { return "DL3090" }
+
+// Metadata describes the rule.
+func (noSensitiveCopy) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Do not copy private keys or credentials into the image",
+		Description: "SSH keys such as `id_rsa`, `*.key` files and `.npmrc` files holding registry tokens stay in the image layers even when a later instruction deletes them. Pass them with `RUN --mount=type=secret` or `--mount=type=ssh` instead.",
+		Category:    engine.CategorySecurity,
+		Tags:        []string{"copy", "add", "context", "secrets"},
+		Severity:    engine.SeverityError,
+		DocsURL:     docsURL("DL3090"),
+	}
+}
+
+// maxListedSecrets bounds the files named in a single finding.
+const maxListedSecrets = 3
+
+// Check reports COPY and ADD instructions whose local sources are sensitive
+// files. Sources are judged by name; with an explicitly given build context,
+// the files they match are inspected, including those below copied
+// directories, and files excluded by .dockerignore are skipped.
+func (noSensitiveCopy) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	explicit := d.Context != nil && d.Context.Explicit
+	for _, n := range d.AST.Children {
+		kw := strings.ToLower(n.Value)
+		if kw != "copy" && kw != "add" {
+			continue
+		}
+		var found []string
+		for _, src := range localSources(n) {
+			rel, ok := contextPath(d, n, src)
+			if !ok || isOutsideContext(rel) {
+				continue
+			}
+			if !explicit {
+				if kind := secretFileKind(rel); kind != "" {
+					found = append(found, rel+" ("+kind+")")
+				}
+				continue
+			}
+			for _, m := range contextMatches(d.Context, rel) {
+				for _, f := range contextFiles(d.Context, m) {
+					if kind := contextSecretKind(d.Context, f); kind != "" {
+						found = append(found, f+" ("+kind+")")
+					}
+				}
+			}
+		}
+		if len(found) == 0 {
+			continue
+		}
+		msg := strings.ToUpper(kw) + " copies " + strings.Join(found[:min(len(found), maxListedSecrets)], ", ")
+		if len(found) > maxListedSecrets {
+			msg += fmt.Sprintf(" and %d more sensitive files", len(found)-maxListedSecrets)
+		}
+		findings = append(findings, engine.Finding{RuleID: "DL3090", Message: msg + " into the image", Line: n.StartLine})
+	}
+	return findings, nil
+}
+
+// contextSecretKind describes the secret held by the context-relative file
+// rel, judging .npmrc files by their content, or returns an empty string.
+func contextSecretKind(c *ir.Context, rel string) string {
+	if strings.EqualFold(path.Base(rel), ".npmrc") {
+		if hasNpmToken(filepath.Join(c.Dir, filepath.FromSlash(rel))) {
+			return "npm registry credentials"
+		}
+		return ""
+	}
+	return secretFileKind(rel)
+}
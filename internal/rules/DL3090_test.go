@@ -0,0 +1,65 @@
+// file: internal/rules/DL3090_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestIntegrationNoSensitiveCopyID validates rule identity.
+func TestIntegrationNoSensitiveCopyID(t *testing.T) {
+	if NewNoSensitiveCopy().ID() != "DL3090" {
+		t.Fatalf("unexpected id")
+	}
+}
+
+// TestIntegrationNoSensitiveCopyNames flags sensitive sources by name without a build context.
+func TestIntegrationNoSensitiveCopyNames(t *testing.T) {
+	cases := map[string]int{
+		"FROM alpine:3.19\nCOPY id_rsa /root/.ssh/id_rsa\n":          1,
+		"FROM alpine:3.19\nCOPY .ssh/id_ed25519 certs/tls.key /x/\n": 1,
+		"FROM alpine:3.19\nARG KEY=server.KEY\nADD ${KEY} /etc/\n":   1,
+		"FROM alpine:3.19\nCOPY id_rsa.pub /root/.ssh/\n":            0,
+		"FROM alpine:3.19\nCOPY .npmrc /root/\n":                     0,
+		"FROM alpine:3.19\nCOPY --from=keys /id_rsa /root/.ssh/\n":   0,
+		"FROM alpine:3.19\nCOPY keyring /etc/\n":                     0,
+	}
+	for src, want := range cases {
+		if findings := checkRule(t, NewNoSensitiveCopy(), src); len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %#v", src, want, findings)
+		}
+	}
+}
+
+// TestIntegrationNoSensitiveCopyContext inspects the files copied from an explicit build context.
+func TestIntegrationNoSensitiveCopyContext(t *testing.T) {
+	cases := []struct {
+		src   string
+		files map[string]string
+		want  int
+	}{
+		{"FROM node:22\nCOPY . /app\n", map[string]string{"deploy/id_rsa": "k", "src/a.js": ""}, 1},
+		{"FROM node:22\nCOPY . /app\n", map[string]string{"deploy/id_rsa": "k", ".dockerignore": "deploy\n"}, 0},
+		{"FROM node:22\nCOPY . /app\n", map[string]string{"deploy/id_rsa": "k", ".dockerignore": "deploy\n!deploy/id_rsa\n"}, 1},
+		{"FROM node:22\nCOPY .npmrc /root/\n", map[string]string{".npmrc": "//registry.npmjs.org/:_authToken=abc123\n"}, 1},
+		{"FROM node:22\nCOPY .npmrc /root/\n", map[string]string{".npmrc": "//registry.npmjs.org/:_authToken=${NPM_TOKEN}\n"}, 0},
+		{"FROM node:22\nCOPY .npmrc /root/\n", map[string]string{".npmrc": "registry=https://registry.example.com/\n"}, 0},
+		{"FROM node:22\nCOPY certs/*.key /etc/ssl/\n", map[string]string{"certs/a.key": "k", "certs/b.key": "k"}, 1},
+		{"FROM node:22\nCOPY src /app/src\n", map[string]string{"src/a.js": "", "id_rsa": "k"}, 0},
+	}
+	for _, c := range cases {
+		if findings := checkExplicitContextRule(t, NewNoSensitiveCopy(), c.src, c.files); len(findings) != c.want {
+			t.Fatalf("%q with %v: expected %d findings, got %#v", c.src, c.files, c.want, findings)
+		}
+	}
+}
+
+// TestIntegrationNoSensitiveCopyMessage lists a bounded number of files.
+func TestIntegrationNoSensitiveCopyMessage(t *testing.T) {
+	files := map[string]string{"a.key": "", "b.key": "", "c.key": "", "d.key": "", "e.key": ""}
+	findings := checkExplicitContextRule(t, NewNoSensitiveCopy(), "FROM alpine:3.19\nCOPY . /x\n", files)
+	if len(findings) != 1 || !strings.Contains(findings[0].Message, "a.key (private key)") || !strings.Contains(findings[0].Message, "and 2 more sensitive files") {
+		t.Fatalf("unexpected findings %#v", findings)
+	}
+}
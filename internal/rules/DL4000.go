@@ -19,6 +19,19 @@ func NewDeprecatedMaintainer() engine.Rule { return deprecatedMaintainer{} }
 // ID returns the rule identifier.
 func (deprecatedMaintainer) ID() string { return "DL4000" }
 
+// Metadata describes the rule.
+func (deprecatedMaintainer) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "MAINTAINER is deprecated",
+		Description: "The `MAINTAINER` instruction is obsolete. Declare the image author with `LABEL maintainer=\"name\"` instead.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"maintainer", "deprecated"},
+		Severity:    engine.SeverityError,
+		Fixable:     true,
+		DocsURL:     docsURL("DL4000"),
+	}
+}
+
 // Check scans the AST for MAINTAINER instructions.
 func (deprecatedMaintainer) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
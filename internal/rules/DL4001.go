@@ -19,6 +19,18 @@ func NewExclusiveCurlWget() engine.Rule { return exclusiveCurlWget{} }
 // ID returns the rule identifier.
 func (exclusiveCurlWget) ID() string { return "DL4001" }
 
+// Metadata describes the rule.
+func (exclusiveCurlWget) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Either use Wget or Curl but not both",
+		Description: "Avoid installing or invoking both `curl` and `wget` in the same stage. Choose a single tool to reduce image size and complexity.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"curl", "wget"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL4001"),
+	}
+}
+
 // Check scans RUN instructions for mixed use of curl and wget.
 func (exclusiveCurlWget) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
@@ -36,7 +48,7 @@ func (exclusiveCurlWget) Check(ctx context.Context, d *ir.Document) ([]engine.Fi
 		if !strings.EqualFold(n.Value, "run") {
 			continue
 		}
-		cmds := extractCommands(n)
+		cmds := extractCommands(d, n)
 		usesCurl := false
 		usesWget := false
 		for _, cmd := range cmds {
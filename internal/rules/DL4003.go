@@ -19,6 +19,18 @@ func NewSingleCmd() engine.Rule { return singleCmd{} }
 // ID returns the rule identifier.
 func (singleCmd) ID() string { return "DL4003" }
 
+// Metadata describes the rule.
+func (singleCmd) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Multiple CMD instructions",
+		Description: "Multiple `CMD` instructions found. If you list more than one `CMD` then only the last `CMD` will take effect.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"cmd"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL4003"),
+	}
+}
+
 // Check scans each stage for multiple CMD instructions.
 func (singleCmd) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
@@ -19,6 +19,18 @@ func NewSingleEntrypoint() engine.Rule { return singleEntrypoint{} }
 // ID returns the rule identifier.
 func (singleEntrypoint) ID() string { return "DL4004" }
 
+// Metadata describes the rule.
+func (singleEntrypoint) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Multiple ENTRYPOINT instructions",
+		Description: "Listing more than one `ENTRYPOINT` instruction in a single stage means only the last takes effect. Remove redundant `ENTRYPOINT` directives to avoid confusion.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"entrypoint"},
+		Severity:    engine.SeverityError,
+		DocsURL:     docsURL("DL4004"),
+	}
+}
+
 // Check scans stages for multiple ENTRYPOINT instructions.
 func (singleEntrypoint) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
@@ -19,24 +19,31 @@ func NewUseShellForDefault() engine.Rule { return useShellForDefault{} }
 // ID returns the rule identifier.
 func (useShellForDefault) ID() string { return "DL4005" }
 
+// Metadata describes the rule.
+func (useShellForDefault) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Use SHELL to change the default shell",
+		Description: "Changing the default shell by linking to `/bin/sh` within a `RUN` instruction is discouraged. Use the `SHELL` directive to specify a different default shell for subsequent commands.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"shell"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL4005"),
+	}
+}
+
 // Check scans RUN instructions for ln commands targeting /bin/sh.
 func (useShellForDefault) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
 	if d == nil || d.AST == nil {
 		return findings, nil
 	}
-	for _, n := range d.AST.Children {
-		if !strings.EqualFold(n.Value, "run") {
-			continue
-		}
-		tokens := runTokens(n)
-		cmds := splitTokens(tokens)
-		for _, cmd := range cmds {
-			if lnTargetsBinSh(cmd) {
+	for _, n := range d.Instructions("run") {
+		for _, c := range d.RunCommands(n) {
+			if lnTargetsBinSh(c.Argv) {
 				findings = append(findings, engine.Finding{
 					RuleID:  "DL4005",
 					Message: "Use SHELL to change the default shell",
-					Line:    n.StartLine,
+					Line:    c.Line,
 				})
 				break
 			}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/asymmetric-effort/docker-lint/internal/engine"
 	"github.com/asymmetric-effort/docker-lint/internal/ir"
+	"github.com/asymmetric-effort/docker-lint/internal/shell"
 )
 
 // pipefailBeforePipe flags RUN instructions with pipes without preceding SHELL -o pipefail.
@@ -21,6 +22,18 @@ func NewPipefailBeforePipe() engine.Rule { return pipefailBeforePipe{} }
 // ID returns the rule identifier.
 func (pipefailBeforePipe) ID() string { return "DL4006" }
 
+// Metadata describes the rule.
+func (pipefailBeforePipe) Metadata() engine.Metadata {
+	return engine.Metadata{
+		Title:       "Set the SHELL option -o pipefail before RUN with a pipe in it",
+		Description: "Set the `SHELL` option `-o pipefail` before a `RUN` instruction containing a pipe. Without `pipefail`, errors in piped commands might be masked. If you are using `/bin/sh` in an alpine image or if your shell is symlinked to busybox then consider explicitly setting your `SHELL` to `/bin/ash`, or disable this check.",
+		Category:    engine.CategoryMaintainability,
+		Tags:        []string{"run", "shell", "pipefail"},
+		Severity:    engine.SeverityWarning,
+		DocsURL:     docsURL("DL4006"),
+	}
+}
+
 // Check evaluates the Dockerfile for missing pipefail.
 func (pipefailBeforePipe) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
 	var findings []engine.Finding
@@ -41,7 +54,7 @@ func (pipefailBeforePipe) Check(ctx context.Context, d *ir.Document) ([]engine.F
 				pipefail = hasPipefailOption(n, valid)
 			}
 		case "run":
-			if !pipefail && runHasPipe(n) {
+			if !pipefail && runHasPipe(d, n) {
 				findings = append(findings, engine.Finding{
 					RuleID:  "DL4006",
 					Message: "Set the SHELL option -o pipefail before RUN with a pipe in it. If you are using /bin/sh in an alpine image or if your shell is symlinked to busybox then consider explicitly setting your SHELL to /bin/ash, or disable this check",
@@ -84,8 +97,8 @@ func hasPipefailOption(n *parser.Node, valid map[string]bool) bool {
 	return false
 }
 
-// runHasPipe detects whether a RUN command contains a pipe.
-func runHasPipe(n *parser.Node) bool {
+// runHasPipe detects whether a RUN command, or a heredoc script it executes, contains a pipe.
+func runHasPipe(d *ir.Document, n *parser.Node) bool {
 	if n == nil || n.Next == nil {
 		return false
 	}
@@ -97,5 +110,22 @@ func runHasPipe(n *parser.Node) bool {
 		}
 		return false
 	}
-	return strings.Contains(n.Next.Value, "|")
+	for _, s := range d.RunScripts(n) {
+		if hasPipeline(s.List) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasPipeline reports whether a script joins two or more commands with a pipe.
+func hasPipeline(list *shell.List) bool {
+	found := false
+	shell.Inspect(list, func(n shell.Node) bool {
+		if p, ok := n.(*shell.Pipeline); ok && len(p.Cmds) > 1 {
+			found = true
+		}
+		return !found
+	})
+	return found
 }
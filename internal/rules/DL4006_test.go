@@ -134,3 +134,29 @@ func TestIntegrationPipefailBeforePipeNil(t *testing.T) {
 		t.Fatalf("expected no findings on empty doc: %v %v", f, err)
 	}
 }
+
+// TestIntegrationPipefailBeforePipeHeredoc detects pipes in heredoc scripts but not in quoted text.
+func TestIntegrationPipefailBeforePipeHeredoc(t *testing.T) {
+	cases := map[string]int{
+		"FROM alpine\nRUN <<EOF\nwget -O- https://x | tar x\nEOF\n":                1,
+		"FROM alpine\nRUN echo 'a|b'\n":                                            0,
+		"FROM alpine\nRUN <<EOF\n#!/usr/bin/env python3\nprint('a' or 'b')\nEOF\n": 0,
+	}
+	for src, want := range cases {
+		res, err := parser.Parse(strings.NewReader(src))
+		if err != nil {
+			t.Fatalf("parse failed: %v", err)
+		}
+		doc, err := ir.BuildDocument("Dockerfile", res.AST)
+		if err != nil {
+			t.Fatalf("build document: %v", err)
+		}
+		findings, err := NewPipefailBeforePipe().Check(context.Background(), doc)
+		if err != nil {
+			t.Fatalf("check failed: %v", err)
+		}
+		if len(findings) != want {
+			t.Fatalf("%q: expected %d findings, got %d", src, want, len(findings))
+		}
+	}
+}
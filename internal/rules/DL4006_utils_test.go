@@ -46,23 +46,23 @@ func TestHasPipefailOption(t *testing.T) {
 
 // TestRunHasPipe covers shell and JSON RUN forms.
 func TestRunHasPipe(t *testing.T) {
-	if runHasPipe(nil) {
+	if runHasPipe(nil, nil) {
 		t.Fatalf("nil node should be false")
 	}
 	jsonPipe := &parser.Node{Attributes: map[string]bool{"json": true}, Next: &parser.Node{Value: "echo", Next: &parser.Node{Value: "|"}}}
-	if !runHasPipe(jsonPipe) {
+	if !runHasPipe(nil, jsonPipe) {
 		t.Fatalf("expected pipe in json form")
 	}
 	jsonNo := &parser.Node{Attributes: map[string]bool{"json": true}, Next: &parser.Node{Value: "echo"}}
-	if runHasPipe(jsonNo) {
+	if runHasPipe(nil, jsonNo) {
 		t.Fatalf("unexpected pipe detection")
 	}
 	shPipe := &parser.Node{Next: &parser.Node{Value: "echo hi | grep h"}}
-	if !runHasPipe(shPipe) {
+	if !runHasPipe(nil, shPipe) {
 		t.Fatalf("expected pipe in shell form")
 	}
 	shNo := &parser.Node{Next: &parser.Node{Value: "echo hi"}}
-	if runHasPipe(shNo) {
+	if runHasPipe(nil, shNo) {
 		t.Fatalf("unexpected pipe detection")
 	}
 }
@@ -0,0 +1,199 @@
+// file: internal/rules/context_utils.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/dockerignore"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// localSources returns the build context sources of a COPY or ADD
+// instruction, leaving out copies from other stages or images, remote URLs,
+// Git repositories and heredocs.
+func localSources(n *parser.Node) []string {
+	if _, ok := copyFromFlag(n); ok {
+		return nil
+	}
+	args := collectArgs(n)
+	if len(args) < 2 {
+		return nil
+	}
+	var out []string
+	for _, src := range args[:len(args)-1] {
+		if isURL(src) || isGitSource(src) || strings.HasPrefix(src, "<<") {
+			continue
+		}
+		out = append(out, src)
+	}
+	return out
+}
+
+// isWholeContext reports whether a COPY or ADD source names the entire
+// build context, as . and * do.
+func isWholeContext(src string) bool {
+	switch path.Clean("/" + src) {
+	case "/", "/*":
+		return true
+	}
+	return false
+}
+
+// contextSecrets lists files that must not reach the image, each with
+// context paths that an ignore file has to exclude to keep them out.
+var contextSecrets = []struct {
+	label   string
+	samples []string
+}{
+	{".git", []string{".git"}},
+	{".env*", []string{".env", ".env.local"}},
+	{"*.pem", []string{"key.pem"}},
+	{"node_modules", []string{"node_modules"}},
+}
+
+// contextPath returns the context-relative slash path that a COPY or ADD
+// source of instruction n refers to, with build variables substituted. It
+// reports false when the source references a variable without a known value.
+func contextPath(d *ir.Document, n *parser.Node, src string) (string, bool) {
+	v, ok := d.Expand(n, src)
+	if !ok {
+		return "", false
+	}
+	return path.Clean(strings.TrimPrefix(filepath.ToSlash(v), "/")), true
+}
+
+// isOutsideContext reports whether a context-relative path climbs above the
+// context root.
+func isOutsideContext(rel string) bool {
+	return rel == ".." || strings.HasPrefix(rel, "../")
+}
+
+// contextMatches returns the context-relative paths that the source pattern
+// rel matches on disk, before .dockerignore filtering.
+func contextMatches(c *ir.Context, rel string) []string {
+	matches, err := filepath.Glob(filepath.Join(c.Dir, filepath.FromSlash(rel)))
+	if err != nil {
+		return nil
+	}
+	out := make([]string, 0, len(matches))
+	for _, m := range matches {
+		if r, err := filepath.Rel(c.Dir, m); err == nil {
+			out = append(out, filepath.ToSlash(r))
+		}
+	}
+	return out
+}
+
+// hasExceptions reports whether an ignore file has ! exceptions, which can
+// re-include paths below an excluded directory.
+func hasExceptions(f *dockerignore.File) bool {
+	if f == nil {
+		return false
+	}
+	for _, p := range f.Patterns {
+		if p.Negate && p.Err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// contextIncludes reports whether the context-relative path rel reaches the
+// build context: it is not excluded, or it is a directory with a descendant
+// that an exception re-includes.
+func contextIncludes(c *ir.Context, rel string) bool {
+	if !c.Ignore.Excludes(rel) {
+		return true
+	}
+	if !hasExceptions(c.Ignore) {
+		return false
+	}
+	found := false
+	_ = filepath.WalkDir(filepath.Join(c.Dir, filepath.FromSlash(rel)), func(p string, _ fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if r, err := filepath.Rel(c.Dir, p); err == nil && !c.Ignore.Excludes(filepath.ToSlash(r)) {
+			found = true
+			return filepath.SkipAll
+		}
+		return nil
+	})
+	return found
+}
+
+// excludingPattern returns the last pattern of f that excludes the
+// context-relative path rel, or nil when rel is not excluded.
+func excludingPattern(f *dockerignore.File, rel string) *dockerignore.Pattern {
+	if !f.Excludes(rel) {
+		return nil
+	}
+	for i := len(f.Patterns) - 1; i >= 0; i-- {
+		if p := &f.Patterns[i]; !p.Negate && p.Matches(rel) {
+			return p
+		}
+	}
+	return nil
+}
+
+// contextFiles returns the context-relative paths of the regular files at or
+// below rel that reach the build context.
+func contextFiles(c *ir.Context, rel string) []string {
+	var out []string
+	exceptions := hasExceptions(c.Ignore)
+	_ = filepath.WalkDir(filepath.Join(c.Dir, filepath.FromSlash(rel)), func(p string, e fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		r, err := filepath.Rel(c.Dir, p)
+		if err != nil {
+			return nil
+		}
+		r = filepath.ToSlash(r)
+		if c.Ignore.Excludes(r) {
+			if e.IsDir() && !exceptions {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if e.Type().IsRegular() {
+			out = append(out, r)
+		}
+		return nil
+	})
+	return out
+}
+
+// sshKeyNames lists the default file names of SSH private keys.
+var sshKeyNames = map[string]struct{}{"id_rsa": {}, "id_dsa": {}, "id_ecdsa": {}, "id_ed25519": {}}
+
+// secretFileKind describes the kind of secret a file holds judging by its
+// name alone, or returns an empty string.
+func secretFileKind(name string) string {
+	base := strings.ToLower(path.Base(name))
+	if _, ok := sshKeyNames[base]; ok {
+		return "SSH private key"
+	}
+	if path.Ext(base) == ".key" {
+		return "private key"
+	}
+	return ""
+}
+
+// npmToken matches .npmrc settings holding a literal credential rather than
+// an ${ENV} reference.
+var npmToken = regexp.MustCompile(`(?m)^\s*(\S*:)?(_authToken|_auth|_password)\s*=\s*[^\s$]`)
+
+// hasNpmToken reports whether the .npmrc file at p stores a credential.
+func hasNpmToken(p string) bool {
+	src, err := os.ReadFile(p)
+	return err == nil && npmToken.Match(src)
+}
@@ -0,0 +1,310 @@
+// file: internal/rules/custom.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/config"
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// severities lists the accepted custom rule severities.
+var severities = map[string]struct{}{"error": {}, "warning": {}, "info": {}, "style": {}}
+
+// customRule is a rule compiled from a custom-rules configuration entry.
+type customRule struct {
+	id          string
+	severity    string
+	message     string
+	category    engine.Category
+	docsURL     string
+	instruction string
+	require     bool
+
+	regex      *regexp.Regexp
+	notRegex   *regexp.Regexp
+	command    string
+	notCommand string
+	flag       string
+	notFlag    string
+	labelKey   *regexp.Regexp
+	labelValue *regexp.Regexp
+}
+
+// NewCustomRules compiles custom rule declarations into rules.
+//
+// It fails on a missing ID or message, a duplicate ID, an unknown severity or
+// an invalid regular expression, naming the offending rule.
+func NewCustomRules(defs []config.CustomRule) ([]engine.Rule, error) {
+	var out []engine.Rule
+	seen := map[string]struct{}{}
+	for i, def := range defs {
+		if def.ID == "" {
+			return nil, fmt.Errorf("custom rule %d: missing id", i+1)
+		}
+		if _, dup := seen[def.ID]; dup {
+			return nil, fmt.Errorf("custom rule %s: duplicate id", def.ID)
+		}
+		seen[def.ID] = struct{}{}
+		r, err := compileCustomRule(def)
+		if err != nil {
+			return nil, fmt.Errorf("custom rule %s: %w", def.ID, err)
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+// parseCategory validates an optional configured category.
+func parseCategory(s string) (engine.Category, error) {
+	if s == "" {
+		return "", nil
+	}
+	c, ok := engine.ParseCategory(s)
+	if !ok {
+		return "", fmt.Errorf("unknown category %q", s)
+	}
+	return c, nil
+}
+
+// compileCustomRule validates def and compiles its regular expressions.
+func compileCustomRule(def config.CustomRule) (*customRule, error) {
+	if def.Message == "" {
+		return nil, fmt.Errorf("missing message")
+	}
+	sev := strings.ToLower(def.Severity)
+	if sev == "" {
+		sev = "warning"
+	}
+	if _, ok := severities[sev]; !ok {
+		return nil, fmt.Errorf("unknown severity %q", def.Severity)
+	}
+	category, err := parseCategory(def.Category)
+	if err != nil {
+		return nil, err
+	}
+	r := &customRule{
+		id:          def.ID,
+		severity:    sev,
+		message:     def.Message,
+		category:    category,
+		docsURL:     def.DocsURL,
+		instruction: strings.ToLower(def.Instruction),
+		require:     def.Require,
+		command:     strings.ToLower(def.Match.Command),
+		notCommand:  strings.ToLower(def.Match.NotCommand),
+		flag:        def.Match.Flag,
+		notFlag:     def.Match.NotFlag,
+	}
+	for _, re := range []struct {
+		name string
+		expr string
+		dst  **regexp.Regexp
+	}{
+		{"regex", def.Match.Regex, &r.regex},
+		{"not-regex", def.Match.NotRegex, &r.notRegex},
+		{"label-key", def.Match.LabelKey, &r.labelKey},
+		{"label-value", def.Match.LabelValue, &r.labelValue},
+	} {
+		if re.expr == "" {
+			continue
+		}
+		c, err := regexp.Compile(re.expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", re.name, err)
+		}
+		*re.dst = c
+	}
+	return r, nil
+}
+
+// ID returns the configured rule identifier.
+func (r *customRule) ID() string { return r.id }
+
+// Metadata describes the rule from its declaration.
+func (r *customRule) Metadata() engine.Metadata {
+	return engine.Metadata{Title: r.message, Category: r.category, Tags: []string{"custom"}, Severity: r.severity, DocsURL: r.docsURL}
+}
+
+// Check reports matching instructions, or the build target when a required match is absent.
+func (r *customRule) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	if r.require {
+		return r.checkRequired(d), nil
+	}
+	for _, n := range r.selected(d, d.AST.Children) {
+		if r.matches(d, n) {
+			findings = append(findings, r.finding(n.StartLine))
+		}
+	}
+	return findings, nil
+}
+
+// checkRequired reports the build target when none of its instructions match.
+//
+// Instructions inherited through FROM <stage> count towards the target.
+func (r *customRule) checkRequired(d *ir.Document) []engine.Finding {
+	nodes := d.AST.Children
+	line := 0
+	if d.Target >= 0 && d.Target < len(d.Stages) {
+		nodes = nil
+		target := d.Stages[d.Target]
+		for _, st := range stageLineage(d, target) {
+			nodes = append(nodes, st.Node)
+			nodes = append(nodes, st.Instructions...)
+		}
+		line = target.Node.StartLine
+	}
+	for _, n := range r.selected(d, nodes) {
+		if r.matches(d, n) {
+			return nil
+		}
+	}
+	return []engine.Finding{r.finding(line)}
+}
+
+// selected returns the nodes whose keyword matches the rule's instruction selector.
+func (r *customRule) selected(d *ir.Document, nodes []*parser.Node) []*parser.Node {
+	if r.instruction == "" {
+		return nodes
+	}
+	var out []*parser.Node
+	for _, n := range nodes {
+		if n != nil && strings.EqualFold(n.Value, r.instruction) {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+// finding builds a finding for line carrying the configured message and severity.
+func (r *customRule) finding(line int) engine.Finding {
+	return engine.Finding{RuleID: r.id, Message: r.message, Line: line, Severity: r.severity}
+}
+
+// matches reports whether n satisfies every configured predicate.
+func (r *customRule) matches(d *ir.Document, n *parser.Node) bool {
+	if r.regex != nil || r.notRegex != nil {
+		args := instructionArgs(n)
+		if r.regex != nil && !r.regex.MatchString(args) {
+			return false
+		}
+		if r.notRegex != nil && r.notRegex.MatchString(args) {
+			return false
+		}
+	}
+	var cmds [][]string
+	if r.command != "" || r.notCommand != "" || r.flag != "" || r.notFlag != "" {
+		cmds = customCommands(d, n)
+	}
+	if r.command != "" && !hasCommand(cmds, r.command) {
+		return false
+	}
+	if r.notCommand != "" && hasCommand(cmds, r.notCommand) {
+		return false
+	}
+	if r.flag != "" && !r.hasFlag(n, cmds, r.flag) {
+		return false
+	}
+	if r.notFlag != "" && r.hasFlag(n, cmds, r.notFlag) {
+		return false
+	}
+	if r.labelKey != nil || r.labelValue != nil {
+		return r.hasLabel(n)
+	}
+	return true
+}
+
+// hasFlag reports whether flag is passed to the instruction or, for RUN, to
+// the selected command or any command when no command is configured.
+func (r *customRule) hasFlag(n *parser.Node, cmds [][]string, flag string) bool {
+	if containsFlag(n.Flags, flag) {
+		return true
+	}
+	for _, argv := range cmds {
+		if r.command != "" && commandName(argv) != r.command {
+			continue
+		}
+		if containsFlag(argv[1:], flag) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasLabel reports whether a LABEL pair of n matches the key and value expressions.
+func (r *customRule) hasLabel(n *parser.Node) bool {
+	for _, p := range ir.LabelPairs(n) {
+		if r.labelKey != nil && !r.labelKey.MatchString(p.Key) {
+			continue
+		}
+		if r.labelValue != nil && !r.labelValue.MatchString(p.Value) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// customCommands returns the unwrapped argument vectors of the commands run by n.
+func customCommands(d *ir.Document, n *parser.Node) [][]string {
+	if !strings.EqualFold(n.Value, "run") {
+		return nil
+	}
+	var out [][]string
+	for _, c := range d.RunCommands(n) {
+		if argv := unwrapCommand(c.Argv); len(argv) > 0 {
+			out = append(out, argv)
+		}
+	}
+	return out
+}
+
+// commandName returns the lowercase base name of the command in argv.
+func commandName(argv []string) string { return strings.ToLower(path.Base(argv[0])) }
+
+// hasCommand reports whether any command is named name.
+func hasCommand(cmds [][]string, name string) bool {
+	for _, argv := range cmds {
+		if commandName(argv) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// containsFlag reports whether args contain flag on its own or as flag=value.
+func containsFlag(args []string, flag string) bool {
+	for _, a := range args {
+		if a == flag || strings.HasPrefix(a, flag+"=") {
+			return true
+		}
+	}
+	return false
+}
+
+// instructionArgs returns the source of n after its keyword, followed by any heredoc bodies.
+func instructionArgs(n *parser.Node) string {
+	src := strings.TrimLeftFunc(n.Original, unicode.IsSpace)
+	if i := strings.IndexFunc(src, unicode.IsSpace); i >= 0 {
+		src = strings.TrimSpace(src[i:])
+	} else {
+		src = ""
+	}
+	for _, h := range n.Heredocs {
+		src += "\n" + h.Content
+	}
+	return src
+}
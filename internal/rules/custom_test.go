@@ -0,0 +1,152 @@
+// file: internal/rules/custom_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/config"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// checkCustom compiles def and returns the lines it reports for src.
+func checkCustom(t *testing.T, def config.CustomRule, src string) []int {
+	t.Helper()
+	res, err := parser.Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	doc, err := ir.BuildDocument("Dockerfile", res.AST)
+	if err != nil {
+		t.Fatalf("build doc: %v", err)
+	}
+	rules, err := NewCustomRules([]config.CustomRule{def})
+	if err != nil {
+		t.Fatalf("compile: %v", err)
+	}
+	findings, err := rules[0].Check(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("check failed: %v", err)
+	}
+	var lines []int
+	for _, f := range findings {
+		if f.RuleID != def.ID || f.Message != def.Message || f.Severity == "" {
+			t.Fatalf("unexpected finding: %+v", f)
+		}
+		lines = append(lines, f.Line)
+	}
+	return lines
+}
+
+// TestIntegrationCustomRules verifies each predicate against matching and clean instructions.
+func TestIntegrationCustomRules(t *testing.T) {
+	cases := []struct {
+		name string
+		def  config.CustomRule
+		src  string
+		want []int
+	}{
+		{
+			name: "regex",
+			def:  config.CustomRule{Instruction: "RUN", Match: config.CustomMatch{Regex: `curl[^|]*\|\s*(ba)?sh`}},
+			src:  "FROM alpine:3.20\nRUN curl -fsSL https://x | sh\nRUN curl -fsSLo x https://x\n",
+			want: []int{2},
+		},
+		{
+			name: "not-regex",
+			def:  config.CustomRule{Instruction: "from", Match: config.CustomMatch{NotRegex: `^ghcr\.io/acme/base-`}},
+			src:  "FROM ghcr.io/acme/base-go:1\nFROM alpine:3.20\n",
+			want: []int{2},
+		},
+		{
+			name: "command and flag",
+			def:  config.CustomRule{Instruction: "RUN", Match: config.CustomMatch{Command: "curl", Flag: "--insecure"}},
+			src:  "FROM alpine:3.20\nRUN sudo curl --insecure https://x\nRUN wget --insecure https://x\n",
+			want: []int{2},
+		},
+		{
+			name: "heredoc command",
+			def:  config.CustomRule{Instruction: "RUN", Match: config.CustomMatch{Command: "apt-get", Flag: "--allow-unauthenticated"}},
+			src:  "FROM debian:12\nRUN <<EOF\napt-get update\napt-get install --allow-unauthenticated git\nEOF\nRUN apt-get install git\n",
+			want: []int{2},
+		},
+		{
+			name: "not-command",
+			def:  config.CustomRule{Instruction: "RUN", Match: config.CustomMatch{NotCommand: "set"}},
+			src:  "FROM alpine:3.20\nRUN set -e && make\nRUN make\n",
+			want: []int{3},
+		},
+		{
+			name: "instruction flag",
+			def:  config.CustomRule{Instruction: "COPY", Match: config.CustomMatch{NotFlag: "--chown"}},
+			src:  "FROM alpine:3.20\nCOPY --chown=app:app a /a\nCOPY b /b\n",
+			want: []int{3},
+		},
+		{
+			name: "label",
+			def:  config.CustomRule{Instruction: "LABEL", Match: config.CustomMatch{LabelKey: `^org\.acme\.team$`, LabelValue: `^$`}},
+			src:  "FROM alpine:3.20\nLABEL org.acme.team=\"\"\nLABEL org.acme.team=core\n",
+			want: []int{2},
+		},
+		{
+			name: "required label missing",
+			def:  config.CustomRule{Instruction: "LABEL", Require: true, Match: config.CustomMatch{LabelKey: `^org\.acme\.team$`}},
+			src:  "FROM alpine:3.20 AS base\nLABEL org.acme.team=core\nFROM alpine:3.20\n",
+			want: []int{3},
+		},
+		{
+			name: "required label inherited",
+			def:  config.CustomRule{Instruction: "LABEL", Require: true, Match: config.CustomMatch{LabelKey: `^org\.acme\.team$`}},
+			src:  "FROM alpine:3.20 AS base\nLABEL org.acme.team=core\nFROM base\n",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.def.ID = "ACME001"
+			tc.def.Message = "policy violation"
+			got := checkCustom(t, tc.def, tc.src)
+			if len(got) != len(tc.want) {
+				t.Fatalf("expected lines %v, got %v", tc.want, got)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Fatalf("expected lines %v, got %v", tc.want, got)
+				}
+			}
+		})
+	}
+}
+
+// TestNewCustomRulesErrors verifies validation of custom rule declarations.
+func TestNewCustomRulesErrors(t *testing.T) {
+	cases := map[string][]config.CustomRule{
+		"missing id":       {{Message: "m"}},
+		"missing message":  {{ID: "X1"}},
+		"duplicate id":     {{ID: "X1", Message: "m"}, {ID: "X1", Message: "m"}},
+		"unknown severity": {{ID: "X1", Message: "m", Severity: "fatal"}},
+		"invalid regex":    {{ID: "X1", Message: "m", Match: config.CustomMatch{Regex: "("}}},
+	}
+	for want, defs := range cases {
+		if _, err := NewCustomRules(defs); err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("expected %q error, got %v", want, err)
+		}
+	}
+}
+
+// TestNewCustomRulesSeverity verifies the default and configured severities.
+func TestNewCustomRulesSeverity(t *testing.T) {
+	rules, err := NewCustomRules([]config.CustomRule{{ID: "X1", Message: "m"}, {ID: "X2", Message: "m", Severity: "Error"}})
+	if err != nil {
+		t.Fatalf("compile: %v", err)
+	}
+	if s := rules[0].(*customRule).severity; s != "warning" {
+		t.Fatalf("expected default warning, got %s", s)
+	}
+	if s := rules[1].(*customRule).severity; s != "error" {
+		t.Fatalf("expected error, got %s", s)
+	}
+}
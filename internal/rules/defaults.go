@@ -0,0 +1,203 @@
+// file: internal/rules/defaults.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/asymmetric-effort/docker-lint/internal/config"
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/plugin"
+)
+
+// Catalog returns new instances of every built-in rule, configured from cfg.
+//
+// Rules whose metadata marks them opt-in only run when a Selection enables
+// them; the others run by default. Catalog fails when cfg holds an invalid
+// rule setting, such as a malformed allowed-ports entry.
+func Catalog(cfg *config.Config) ([]engine.Rule, error) {
+	if cfg == nil {
+		cfg = &config.Config{}
+	}
+	schema := ParseLabelSchema(cfg.LabelSchema)
+	expose, err := NewExposePolicy(cfg.AllowedPorts, cfg.DeniedPorts)
+	if err != nil {
+		return nil, err
+	}
+	return []engine.Rule{
+		NewNoInlineIgnore(),
+		NewAbsoluteWorkdir(),
+		NewNoIrrelevantCommands(),
+		NewLastUserNotRoot(),
+		NewUseWorkdir(),
+		NewNoSudo(),
+		NewRequireTag(),
+		NewNoLatestTag(),
+		NewAptPin(),
+		NewAptListsCleanup(),
+		NewUseADDForArchives(),
+		NewValidPortRange(),
+		NewSingleHealthcheck(),
+		NewPinPipVersions(),
+		NewAptGetYes(),
+		NewAptNoInstallRecommends(),
+		NewPinNpmVersion(),
+		NewApkPin(),
+		NewApkNoCache(),
+		NewUseCopyInsteadOfAdd(),
+		NewCopyDestEndsWithSlash(),
+		NewCopyFromPreviousStage(),
+		NewCopyFromSelf(),
+		NewUniqueStageNames(),
+		NewJSONNotationCmdEntrypoint(),
+		NewAllowedRegistry(cfg.TrustedRegistries),
+		NewNoAptCommand(),
+		NewPinGemVersions(),
+		NewNoPlatformInFrom(),
+		NewRequireYumYes(),
+		NewRequireYumClean(),
+		NewPinYumVersions(),
+		NewRequireZypperYes(),
+		NewForbidZypperDistUpgrade(),
+		NewRequireZypperClean(),
+		NewPinZypperVersions(),
+		NewRequireDnfYes(),
+		NewDnfCacheCleanup(),
+		NewDnfNoUpgrade(),
+		NewCombinePackageRuns(),
+		NewRequireOSVersionTag(),
+		NewDnfVersionPin(),
+		NewCopyFromExternalDigest(),
+		NewApkNoUpgrade(),
+		NewApkCacheCleanup(),
+		NewLabelKeyValid(),
+		NewSuperfluousLabels(schema, cfg.StrictLabels),
+		NewLabelNotEmpty(schema),
+		NewLabelURLValid(schema),
+		NewLabelTimeRFC3339(schema),
+		NewLabelSPDXValid(schema),
+		NewStageDigestPinned(nil),
+		NewLabelSemVerValid(schema),
+		NewHealthcheckExists(),
+		NewLabelEmailValid(schema),
+		NewConsecutiveRun(),
+		NewYarnCacheClean(),
+		NewStartWithFromOrArg(),
+		NewUnreachableStage(),
+		NewDeprecatedMaintainer(),
+		NewExclusiveCurlWget(),
+		NewSingleCmd(),
+		NewSingleEntrypoint(),
+		NewUseShellForDefault(),
+		NewPipefailBeforePipe(),
+		NewSensitiveVariable(),
+		NewSecretValue(),
+		NewNoRemoteExec(),
+		NewNoInsecureTransport(),
+		NewAddChecksum(),
+		NewAddGitPinned(),
+		NewAddRemoteArchive(),
+		NewExplicitUser(),
+		NewNoRootSwitchBack(),
+		NewNumericUser(),
+		NewUserCreated(),
+		NewNoWorldWritable(),
+		NewNoSetID(),
+		NewNoRecursiveRootChown(),
+		NewNoDangerousSetcap(),
+		NewNoInsecureRun(),
+		NewValidExposeSyntax(),
+		NewPrivilegedPortNonRoot(),
+		NewNoManagementPort(),
+		expose,
+		NewValidHealthcheckOptions(),
+		NewHealthcheckToolInstalled(),
+		NewHealthcheckExecForm(),
+		NewIgnoreSensitiveContext(),
+		NewValidIgnorePatterns(),
+		NewSourceInContext(),
+		NewSourceNotIgnored(),
+		NewNoSensitiveCopy(),
+	}, nil
+}
+
+// Selection returns the rule selection configured by the enable, disable and
+// ignored lists of cfg.
+func Selection(cfg *config.Config) engine.Selection {
+	if cfg == nil {
+		return engine.Selection{}
+	}
+	return engine.Selection{Enable: cfg.Enable, Disable: slices.Concat(cfg.Ignored, cfg.Disable)}
+}
+
+// FromConfig compiles the custom rules and policies declared in cfg.
+func FromConfig(cfg *config.Config) ([]engine.Rule, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	custom, err := NewCustomRules(cfg.CustomRules)
+	if err != nil {
+		return nil, err
+	}
+	policies, err := NewPolicyRules(cfg.Policies, cfg.TrustedRegistries)
+	if err != nil {
+		return nil, err
+	}
+	return append(custom, policies...), nil
+}
+
+// All returns every available rule: the built-in rules, the custom rules and
+// policies declared in cfg, the rules of its plugins and extra. It fails when
+// two of them share an ID.
+func All(ctx context.Context, cfg *config.Config, extra ...engine.Rule) ([]engine.Rule, error) {
+	declared, err := FromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	all, err := Catalog(cfg)
+	if err != nil {
+		return nil, err
+	}
+	all = append(all, declared...)
+	if cfg != nil {
+		for _, pc := range cfg.Plugins {
+			p, err := plugin.Load(ctx, pc)
+			if err != nil {
+				return nil, err
+			}
+			all = append(all, p.Rules()...)
+		}
+	}
+	all = append(all, extra...)
+	if err := engine.CheckIDs(all); err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+// Load returns the rules of All that sel selects, with plugin failures
+// assigned to the selected rules.
+//
+// It fails when an enable or only pattern of sel matches no rule.
+func Load(ctx context.Context, cfg *config.Config, sel engine.Selection, extra ...engine.Rule) ([]engine.Rule, error) {
+	all, err := All(ctx, cfg, extra...)
+	if err != nil {
+		return nil, err
+	}
+	if err := sel.Validate(); err != nil {
+		return nil, err
+	}
+	if unmatched := sel.Unmatched(all); len(unmatched) > 0 {
+		return nil, fmt.Errorf("no rule matches %q", unmatched[0])
+	}
+	var kept []engine.Rule
+	for _, r := range all {
+		if sel.Selects(r) {
+			kept = append(kept, r)
+		}
+	}
+	plugin.AssignFailureReporters(kept)
+	return kept, nil
+}
@@ -0,0 +1,104 @@
+// file: internal/rules/expose_utils.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// portSpec is a port or port range with its protocol, as in 8000-8010/udp.
+type portSpec struct {
+	Start int
+	End   int
+	Proto string
+}
+
+// exposeProtocols lists the protocols EXPOSE accepts.
+var exposeProtocols = map[string]struct{}{"tcp": {}, "udp": {}, "sctp": {}}
+
+// parsePortSpec parses a port specification of the form port[-port][/proto].
+// The protocol defaults to tcp. Range bounds are left to DL3011.
+func parsePortSpec(s string) (portSpec, error) {
+	if strings.Contains(s, ":") {
+		return portSpec{}, fmt.Errorf("%q is a port mapping; EXPOSE takes container ports only, publish with docker run -p", s)
+	}
+	ports, proto, hasProto := strings.Cut(s, "/")
+	spec := portSpec{Proto: "tcp"}
+	if hasProto {
+		spec.Proto = strings.ToLower(proto)
+		if _, ok := exposeProtocols[spec.Proto]; !ok {
+			return portSpec{}, fmt.Errorf("%q has unknown protocol %q; use tcp, udp or sctp", s, proto)
+		}
+	}
+	first, last, isRange := strings.Cut(ports, "-")
+	var err error
+	if spec.Start, err = strconv.Atoi(first); err != nil || first[0] == '+' {
+		return portSpec{}, fmt.Errorf("%q is not a port number or range", s)
+	}
+	spec.End = spec.Start
+	if isRange {
+		if spec.End, err = strconv.Atoi(last); err != nil || last[0] == '+' {
+			return portSpec{}, fmt.Errorf("%q is not a port number or range", s)
+		}
+		if spec.End < spec.Start {
+			return portSpec{}, fmt.Errorf("%q is a range whose end precedes its start", s)
+		}
+	}
+	return spec, nil
+}
+
+// String formats the specification as EXPOSE writes it.
+func (p portSpec) String() string {
+	s := strconv.Itoa(p.Start)
+	if p.End != p.Start {
+		s += "-" + strconv.Itoa(p.End)
+	}
+	return s + "/" + p.Proto
+}
+
+// exposedPorts returns the port specifications of EXPOSE instruction n with
+// build variables substituted. Words that reference unknown variables are
+// skipped, and a variable holding several ports yields each of them.
+func exposedPorts(d *ir.Document, n *parser.Node) []string {
+	var out []string
+	for arg := n.Next; arg != nil; arg = arg.Next {
+		v, ok := d.Expand(n, arg.Value)
+		if !ok {
+			continue
+		}
+		out = append(out, strings.Fields(v)...)
+	}
+	return out
+}
+
+// exposure is a valid port specification with the EXPOSE instruction declaring it.
+type exposure struct {
+	Port portSpec
+	Node *parser.Node
+}
+
+// targetExposures returns the valid port specifications exposed by the
+// target stage, including those inherited through FROM <stage>.
+func targetExposures(d *ir.Document) []exposure {
+	var out []exposure
+	lineage := stageLineage(d, d.TargetStage())
+	for i := len(lineage) - 1; i >= 0; i-- {
+		for _, n := range lineage[i].Instructions {
+			if !strings.EqualFold(n.Value, "expose") {
+				continue
+			}
+			for _, s := range exposedPorts(d, n) {
+				if spec, err := parsePortSpec(s); err == nil {
+					out = append(out, exposure{Port: spec, Node: n})
+				}
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,28 @@
+// file: internal/rules/expose_utils_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import "testing"
+
+// TestParsePortSpec parses ports, ranges and protocols.
+func TestParsePortSpec(t *testing.T) {
+	valid := map[string]string{
+		"80":            "80/tcp",
+		"53/udp":        "53/udp",
+		"8000-8010":     "8000-8010/tcp",
+		"2905/SCTP":     "2905/sctp",
+		"70000":         "70000/tcp",
+		"1000-1000/tcp": "1000/tcp",
+	}
+	for in, want := range valid {
+		spec, err := parsePortSpec(in)
+		if err != nil || spec.String() != want {
+			t.Fatalf("%q: expected %s, got %v, %v", in, want, spec, err)
+		}
+	}
+	for _, in := range []string{"", "http", "80/", "80/icmp", "-80", "80-", "+80", "90-80", "8080:80", "127.0.0.1:80:80"} {
+		if _, err := parsePortSpec(in); err == nil {
+			t.Fatalf("%q: expected an error", in)
+		}
+	}
+}
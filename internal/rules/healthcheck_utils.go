@@ -0,0 +1,132 @@
+// file: internal/rules/healthcheck_utils.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import (
+	"path"
+	"strings"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// isHealthcheckNone reports whether HEALTHCHECK instruction n disables the check.
+func isHealthcheckNone(n *parser.Node) bool {
+	return n.Next != nil && strings.EqualFold(n.Next.Value, "none")
+}
+
+// stageHealthcheck returns the HEALTHCHECK in effect for st, following
+// inheritance through FROM <stage>, or nil when there is none.
+func stageHealthcheck(d *ir.Document, st *ir.Stage) *parser.Node {
+	for _, s := range stageLineage(d, st) {
+		for i := len(s.Instructions) - 1; i >= 0; i-- {
+			if strings.EqualFold(s.Instructions[i].Value, "healthcheck") {
+				return s.Instructions[i]
+			}
+		}
+	}
+	return nil
+}
+
+// baseImage returns the lowercase external image at the root of st's
+// lineage, with build variables substituted where known.
+func baseImage(d *ir.Document, st *ir.Stage) string {
+	lineage := stageLineage(d, st)
+	if len(lineage) == 0 {
+		return ""
+	}
+	root := lineage[len(lineage)-1]
+	image := root.From
+	if v, ok := d.Expand(root.Node, image); ok {
+		image = v
+	}
+	return strings.ToLower(image)
+}
+
+// splitImage returns the repository and tag of an image reference, dropping
+// any digest.
+func splitImage(image string) (string, string) {
+	image, _, _ = strings.Cut(image, "@")
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[:i], image[i+1:]
+	}
+	return image, ""
+}
+
+// isShellless reports whether a base image ships without /bin/sh, as scratch,
+// distroless images other than their debug variants and Chainguard's static
+// image do.
+func isShellless(image string) bool {
+	repo, tag := splitImage(image)
+	switch {
+	case repo == "scratch":
+		return true
+	case strings.Contains(repo, "distroless"):
+		return !strings.Contains(tag, "debug")
+	}
+	return strings.HasSuffix(repo, "chainguard/static")
+}
+
+// baseLacks reports whether a base image is known to ship without the
+// command name. Images whose contents are unknown are assumed to have it.
+func baseLacks(image, name string) bool {
+	if isShellless(image) {
+		return true
+	}
+	repo, tag := splitImage(image)
+	switch path.Base(repo) {
+	case "alpine", "busybox":
+		// BusyBox provides wget but not curl.
+		return name == "curl"
+	case "debian", "ubuntu":
+		return true
+	}
+	return strings.Contains(tag, "slim")
+}
+
+// installsCommand reports whether a stage of lineage installs the command
+// name, either as a package of the same name or by copying a file with that
+// name.
+func installsCommand(d *ir.Document, lineage []*ir.Stage, name string) bool {
+	for _, st := range lineage {
+		for _, n := range st.Instructions {
+			switch strings.ToLower(n.Value) {
+			case "run":
+				for _, seg := range runSegments(d, n) {
+					if pm := packageManagers[seg.Args[0]]; pm.System && installsPackage(seg.Args, pm.Install, name) {
+						return true
+					}
+				}
+			case "copy", "add":
+				for _, a := range collectArgs(n) {
+					if path.Base(a) == name {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+// installsPackage reports whether a package manager command with the install
+// subcommand sub installs pkg, ignoring version constraints.
+func installsPackage(seg []string, sub, pkg string) bool {
+	i := indexOf(seg, sub)
+	if i < 0 {
+		return false
+	}
+	for _, a := range seg[i+1:] {
+		if strings.HasPrefix(a, "-") {
+			continue
+		}
+		if j := strings.IndexAny(a, "=<>~"); j >= 0 {
+			a = a[:j]
+		}
+		if a == pkg {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+// file: internal/rules/healthcheck_utils_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import "testing"
+
+// TestSplitImage separates repositories from tags and digests.
+func TestSplitImage(t *testing.T) {
+	cases := map[string][2]string{
+		"alpine":                           {"alpine", ""},
+		"debian:12-slim":                   {"debian", "12-slim"},
+		"localhost:5000/app":               {"localhost:5000/app", ""},
+		"localhost:5000/app:1.0@sha256:ab": {"localhost:5000/app", "1.0"},
+	}
+	for in, want := range cases {
+		if repo, tag := splitImage(in); repo != want[0] || tag != want[1] {
+			t.Fatalf("%q: got %q %q", in, repo, tag)
+		}
+	}
+}
+
+// TestBaseLacks classifies base images by the tools they ship.
+func TestBaseLacks(t *testing.T) {
+	cases := []struct {
+		image, tool string
+		want        bool
+	}{
+		{"scratch", "wget", true},
+		{"alpine:3.19", "curl", true},
+		{"alpine:3.19", "wget", false},
+		{"docker.io/library/ubuntu:24.04", "curl", true},
+		{"node:20-slim", "curl", true},
+		{"node:20", "curl", false},
+		{"gcr.io/distroless/cc-debian12:debug", "curl", false},
+	}
+	for _, c := range cases {
+		if got := baseLacks(c.image, c.tool); got != c.want {
+			t.Fatalf("%s %s: expected %v", c.image, c.tool, c.want)
+		}
+	}
+}
@@ -5,11 +5,7 @@ package rules
  * (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
  */
 
-import (
-	"strings"
-
-	"github.com/moby/buildkit/frontend/dockerfile/parser"
-)
+import "strings"
 
 // LabelType represents the expected format for a label value.
 type LabelType int
@@ -27,23 +23,28 @@ const (
 // LabelSchema defines required labels and their expected types.
 type LabelSchema map[string]LabelType
 
-// labelPair holds a key-value label entry.
-type labelPair struct{ Key, Value string }
+// labelTypes maps the type names of a configured label-schema, as used by
+// hadolint, to label types.
+var labelTypes = map[string]LabelType{
+	"url":     LabelTypeURL,
+	"rfc3339": LabelTypeRFC3339,
+	"spdx":    LabelTypeSPDX,
+	"hash":    LabelTypeGitHash,
+	"semver":  LabelTypeSemVer,
+	"email":   LabelTypeEmail,
+}
 
-// collectLabelPairs extracts key-value pairs from a LABEL instruction.
-func collectLabelPairs(n *parser.Node) []labelPair {
-	var pairs []labelPair
-	if n == nil {
-		return pairs
-	}
-	var tokens []string
-	for tok := n.Next; tok != nil; tok = tok.Next {
-		tokens = append(tokens, strings.Trim(tok.Value, "\"'"))
+// ParseLabelSchema converts a configured label-schema into a LabelSchema.
+// Unknown type names, such as text, are treated as plain strings.
+func ParseLabelSchema(m map[string]string) LabelSchema {
+	if len(m) == 0 {
+		return nil
 	}
-	for i := 0; i+2 < len(tokens); i += 3 {
-		pairs = append(pairs, labelPair{Key: tokens[i], Value: tokens[i+1]})
+	schema := make(LabelSchema, len(m))
+	for key, name := range m {
+		schema[key] = labelTypes[strings.ToLower(name)]
 	}
-	return pairs
+	return schema
 }
 
 // inSchema reports whether a key exists in the schema.
@@ -0,0 +1,94 @@
+// file: internal/rules/metadata_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/asymmetric-effort/docker-lint/internal/config"
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+)
+
+// builtinRules returns the catalog for the default configuration.
+func builtinRules(t *testing.T) []engine.Rule {
+	t.Helper()
+	rs, err := Catalog(nil)
+	if err != nil {
+		t.Fatalf("Catalog: %v", err)
+	}
+	return rs
+}
+
+// TestBuiltinRulesMetadata verifies that every built-in rule is fully described.
+func TestBuiltinRulesMetadata(t *testing.T) {
+	seen := map[string]struct{}{}
+	for _, r := range builtinRules(t) {
+		if _, dup := seen[r.ID()]; dup {
+			t.Fatalf("duplicate rule %s", r.ID())
+		}
+		seen[r.ID()] = struct{}{}
+		if _, ok := r.(engine.Describer); !ok {
+			t.Errorf("%s: no metadata", r.ID())
+			continue
+		}
+		m := engine.Describe(r)
+		if m.Title == r.ID() || m.Description == "" {
+			t.Errorf("%s: missing title or description", r.ID())
+		}
+		if _, ok := engine.ParseCategory(string(m.Category)); !ok {
+			t.Errorf("%s: invalid category %q", r.ID(), m.Category)
+		}
+		if _, ok := severities[m.Severity]; !ok {
+			t.Errorf("%s: invalid severity %q", r.ID(), m.Severity)
+		}
+		if !strings.HasSuffix(m.DocsURL, "/"+r.ID()+".md") {
+			t.Errorf("%s: unexpected docs URL %q", r.ID(), m.DocsURL)
+		}
+	}
+}
+
+// TestCatalogOptIn verifies which rules are opt-in and how selections enable them.
+func TestCatalogOptIn(t *testing.T) {
+	var optIn []string
+	for _, r := range builtinRules(t) {
+		if engine.Describe(r).OptIn {
+			optIn = append(optIn, r.ID())
+		}
+	}
+	if strings.Join(optIn, ",") != "DL1001,DL3050,DL3055,DL3057,DL3070,DL3072,DL3073" {
+		t.Fatalf("unexpected opt-in rules %v", optIn)
+	}
+	sel := Selection(&config.Config{Ignored: []string{"DL3007"}, Enable: []string{"dl305*"}, Disable: []string{"DL3055"}})
+	run := map[string]bool{}
+	for _, r := range builtinRules(t) {
+		run[r.ID()] = sel.Selects(r)
+	}
+	if run["DL3007"] || run["DL3055"] || !run["DL3050"] || !run["DL3057"] || run["DL1001"] || !run["DL3006"] {
+		t.Fatalf("unexpected selection %v", run)
+	}
+}
+
+// TestDeclaredRulesMetadata verifies that custom rules and policies carry their configured metadata.
+func TestDeclaredRulesMetadata(t *testing.T) {
+	cfg := &config.Config{
+		CustomRules: []config.CustomRule{{ID: "C1", Message: "no curl", Severity: "error", Category: "Security", DocsURL: "https://example.com/c1"}},
+		Policies:    []config.Policy{{ID: "P1", Message: "no root", Deny: `stage.user == "root"`}},
+	}
+	got, err := FromConfig(cfg)
+	if err != nil {
+		t.Fatalf("FromConfig: %v", err)
+	}
+	c := engine.Describe(got[0])
+	if c.Title != "no curl" || c.Severity != "error" || c.Category != engine.CategorySecurity || c.DocsURL != "https://example.com/c1" {
+		t.Fatalf("unexpected custom metadata %+v", c)
+	}
+	p := engine.Describe(got[1])
+	if p.Title != "no root" || p.Severity != "warning" || p.Category != "" || p.Description != `stage.user == "root"` {
+		t.Fatalf("unexpected policy metadata %+v", p)
+	}
+	cfg.Policies[0].Category = "speed"
+	if _, err := FromConfig(cfg); err == nil || !strings.Contains(err.Error(), `unknown category "speed"`) {
+		t.Fatalf("expected category error, got %v", err)
+	}
+}
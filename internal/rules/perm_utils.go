@@ -0,0 +1,125 @@
+// file: internal/rules/perm_utils.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import (
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// chmodCall is a chmod command of a RUN instruction.
+type chmodCall struct {
+	Mode      string
+	Paths     []string
+	Recursive bool
+	Line      int
+}
+
+// chmodCalls returns the chmod commands run by n, wrappers such as sudo removed.
+func chmodCalls(d *ir.Document, n *parser.Node) []chmodCall {
+	var out []chmodCall
+	for _, c := range d.RunCommands(n) {
+		argv := unwrapCommand(c.Argv)
+		if len(argv) < 3 || commandName(argv) != "chmod" {
+			continue
+		}
+		call := chmodCall{Line: c.Line}
+		for _, a := range argv[1:] {
+			switch {
+			case isRecursiveOption(a):
+				call.Recursive = true
+			case strings.HasPrefix(a, "-") && !isSymbolicMode(a):
+			case call.Mode == "":
+				call.Mode = a
+			default:
+				call.Paths = append(call.Paths, a)
+			}
+		}
+		if call.Mode != "" {
+			out = append(out, call)
+		}
+	}
+	return out
+}
+
+// isRecursiveOption reports whether a chmod, chown or chgrp argument is -R,
+// --recursive or a short option cluster containing R.
+func isRecursiveOption(a string) bool {
+	return a == "--recursive" || (strings.HasPrefix(a, "-") && !strings.HasPrefix(a, "--") && strings.Contains(a, "R"))
+}
+
+// isSymbolicMode reports whether s is a symbolic chmod mode such as a+w or -x.
+func isSymbolicMode(s string) bool {
+	for _, clause := range strings.Split(s, ",") {
+		i := strings.IndexAny(clause, "+-=")
+		if i < 0 || strings.Trim(clause[:i], "ugoa") != "" || strings.Trim(clause[i+1:], "rwxXstugo") != "" {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// octalMode parses a numeric mode such as 755 or 4755.
+func octalMode(s string) (int, bool) {
+	if len(s) < 3 || len(s) > 4 {
+		return 0, false
+	}
+	v, err := strconv.ParseUint(s, 8, 16)
+	return int(v), err == nil
+}
+
+// symbolicGrants reports whether a symbolic mode adds perm for one of the
+// classes. Clauses without a class count only when classless is set, since
+// the umask otherwise limits what they grant.
+func symbolicGrants(mode, classes string, perm byte, classless bool) bool {
+	for _, clause := range strings.Split(mode, ",") {
+		i := strings.IndexAny(clause, "+=")
+		if i < 0 || strings.IndexByte(clause[i+1:], perm) < 0 {
+			continue
+		}
+		who := clause[:i]
+		if (who == "" && classless) || strings.ContainsAny(who, "a"+classes) {
+			return true
+		}
+	}
+	return false
+}
+
+// worldWritableMode reports whether mode makes files writable by others,
+// ignoring modes with the sticky bit such as 1777 for shared /tmp directories.
+func worldWritableMode(mode string) bool {
+	if v, ok := octalMode(mode); ok {
+		return v&0o002 != 0 && v&0o1000 == 0
+	}
+	return isSymbolicMode(mode) && !strings.Contains(mode, "t") && symbolicGrants(mode, "o", 'w', false)
+}
+
+// setIDMode reports whether mode sets the setuid or setgid bit.
+func setIDMode(mode string) bool {
+	if v, ok := octalMode(mode); ok {
+		return v&0o6000 != 0
+	}
+	return isSymbolicMode(mode) && symbolicGrants(mode, "ug", 's', true)
+}
+
+// broadPaths lists directories whose permissions affect the whole image.
+var broadPaths = map[string]struct{}{
+	"/": {}, "/bin": {}, "/boot": {}, "/etc": {}, "/home": {}, "/lib": {}, "/lib64": {}, "/opt": {},
+	"/root": {}, "/run": {}, "/sbin": {}, "/srv": {}, "/usr": {}, "/usr/bin": {}, "/usr/lib": {},
+	"/usr/local": {}, "/usr/local/bin": {}, "/usr/sbin": {}, "/var": {},
+}
+
+// isBroadPath reports whether p is the root directory, a system directory or
+// a glob over the root directory.
+func isBroadPath(p string) bool {
+	if strings.HasPrefix(p, "/*") {
+		return true
+	}
+	_, ok := broadPaths[path.Clean(p)]
+	return ok
+}
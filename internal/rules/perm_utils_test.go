@@ -0,0 +1,41 @@
+// file: internal/rules/perm_utils_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import "testing"
+
+// TestWorldWritableMode classifies octal and symbolic chmod modes.
+func TestWorldWritableMode(t *testing.T) {
+	cases := map[string]bool{
+		"777": true, "0666": true, "o+w": true, "a=rwx": true, "u+x,o+w": true,
+		"755": false, "1777": false, "+w": false, "go-w": false, "a+rwt": false, "app": false,
+	}
+	for mode, want := range cases {
+		if got := worldWritableMode(mode); got != want {
+			t.Fatalf("%q: expected %v, got %v", mode, want, got)
+		}
+	}
+}
+
+// TestSetIDMode classifies setuid and setgid modes.
+func TestSetIDMode(t *testing.T) {
+	cases := map[string]bool{
+		"4755": true, "2755": true, "6755": true, "+s": true, "u+s": true, "g=rxs": true,
+		"0755": false, "1777": false, "o+s": false, "-s": false, "u-s": false,
+	}
+	for mode, want := range cases {
+		if got := setIDMode(mode); got != want {
+			t.Fatalf("%q: expected %v, got %v", mode, want, got)
+		}
+	}
+}
+
+// TestIsBroadPath recognizes the root filesystem and system directories.
+func TestIsBroadPath(t *testing.T) {
+	cases := map[string]bool{"/": true, "/*": true, "/usr/local/": true, "/etc": true, "/app": false, "/etc/app": false, "app": false}
+	for p, want := range cases {
+		if got := isBroadPath(p); got != want {
+			t.Fatalf("%q: expected %v, got %v", p, want, got)
+		}
+	}
+}
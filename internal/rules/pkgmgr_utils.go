@@ -0,0 +1,50 @@
+// file: internal/rules/pkgmgr_utils.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+// packageManager describes the command-line conventions of a package manager.
+type packageManager struct {
+	// Ecosystem groups commands that share options: apt, apk, rpm, zypper or node.
+	Ecosystem string
+	// Install is the subcommand that installs packages.
+	Install string
+	// System is set for managers of operating system packages.
+	System bool
+}
+
+// packageManagers maps package manager commands to their conventions.
+var packageManagers = map[string]packageManager{
+	"apt-get":  {Ecosystem: "apt", Install: "install", System: true},
+	"apt":      {Ecosystem: "apt", Install: "install", System: true},
+	"apk":      {Ecosystem: "apk", Install: "add", System: true},
+	"yum":      {Ecosystem: "rpm", Install: "install", System: true},
+	"dnf":      {Ecosystem: "rpm", Install: "install", System: true},
+	"microdnf": {Ecosystem: "rpm", Install: "install", System: true},
+	"tdnf":     {Ecosystem: "rpm", Install: "install", System: true},
+	"zypper":   {Ecosystem: "zypper", Install: "install", System: true},
+	"npm":      {Ecosystem: "node", Install: "install"},
+	"yarn":     {Ecosystem: "node", Install: "add"},
+	"pnpm":     {Ecosystem: "node", Install: "add"},
+}
+
+// ecosystemOf returns the ecosystem of the package manager command name, or
+// an empty string when name is not a package manager.
+func ecosystemOf(name string) string { return packageManagers[name].Ecosystem }
+
+// installArgs returns the arguments following the install subcommand when
+// tokens run a package manager of ecosystem with only options before it.
+func installArgs(tokens []string, ecosystem string) ([]string, bool) {
+	if len(tokens) == 0 || ecosystemOf(tokens[0]) != ecosystem {
+		return nil, false
+	}
+	install := packageManagers[tokens[0]].Install
+	for j := 1; j < len(tokens); j++ {
+		if tokens[j] == install {
+			return tokens[j+1:], true
+		}
+		if tokens[j] == "" || tokens[j][0] != '-' {
+			return nil, false
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,33 @@
+// file: internal/rules/pkgmgr_utils_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestInstallArgs extracts the packages of install commands by ecosystem.
+func TestInstallArgs(t *testing.T) {
+	cases := []struct {
+		cmd       string
+		ecosystem string
+		want      string
+		ok        bool
+	}{
+		{"apt-get -q install -y curl", "apt", "-y curl", true},
+		{"apt install curl", "apt", "curl", true},
+		{"apk --no-cache add curl", "apk", "curl", true},
+		{"microdnf install curl", "rpm", "curl", true},
+		{"yarn add left-pad", "node", "left-pad", true},
+		{"apt-get update", "apt", "", false},
+		{"apk add curl", "apt", "", false},
+		{"apt-get download install", "apt", "", false},
+	}
+	for _, c := range cases {
+		args, ok := installArgs(strings.Fields(c.cmd), c.ecosystem)
+		if ok != c.ok || strings.Join(args, " ") != c.want {
+			t.Fatalf("%q: expected %q %v, got %q %v", c.cmd, c.want, c.ok, args, ok)
+		}
+	}
+}
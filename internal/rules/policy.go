@@ -0,0 +1,169 @@
+// file: internal/rules/policy.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/config"
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+	"github.com/asymmetric-effort/docker-lint/internal/policy"
+)
+
+// policyScopes lists the variables bound in each policy scope.
+var policyScopes = map[string][]string{
+	"document":    {"document", "trusted"},
+	"stage":       {"document", "trusted", "stage", "from"},
+	"instruction": {"document", "trusted", "stage", "from", "instruction"},
+}
+
+// policyRule is a rule compiled from a policies configuration entry.
+type policyRule struct {
+	id       string
+	severity string
+	message  string
+	category engine.Category
+	docsURL  string
+	scope    string
+	expr     *policy.Expr
+	deny     bool
+	trusted  []policy.Value
+}
+
+// NewPolicyRules compiles policy declarations into rules.
+//
+// trusted is exposed to expressions as the list variable trusted. It fails on
+// a missing ID or message, a duplicate ID, an unknown severity or scope, or an
+// invalid expression, naming the offending policy.
+func NewPolicyRules(defs []config.Policy, trusted []string) ([]engine.Rule, error) {
+	list := make([]policy.Value, 0, len(trusted))
+	for _, t := range trusted {
+		list = append(list, t)
+	}
+	var out []engine.Rule
+	seen := map[string]struct{}{}
+	for i, def := range defs {
+		if def.ID == "" {
+			return nil, fmt.Errorf("policy %d: missing id", i+1)
+		}
+		if _, dup := seen[def.ID]; dup {
+			return nil, fmt.Errorf("policy %s: duplicate id", def.ID)
+		}
+		seen[def.ID] = struct{}{}
+		r, err := compilePolicy(def)
+		if err != nil {
+			return nil, fmt.Errorf("policy %s: %w", def.ID, err)
+		}
+		r.trusted = list
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+// compilePolicy validates def and compiles its expression.
+func compilePolicy(def config.Policy) (*policyRule, error) {
+	if def.Message == "" {
+		return nil, fmt.Errorf("missing message")
+	}
+	sev := strings.ToLower(def.Severity)
+	if sev == "" {
+		sev = "warning"
+	}
+	if _, ok := severities[sev]; !ok {
+		return nil, fmt.Errorf("unknown severity %q", def.Severity)
+	}
+	scope := strings.ToLower(def.For)
+	if scope == "" {
+		scope = "stage"
+	}
+	vars, ok := policyScopes[scope]
+	if !ok {
+		return nil, fmt.Errorf("unknown scope %q", def.For)
+	}
+	if (def.Deny == "") == (def.Require == "") {
+		return nil, fmt.Errorf("exactly one of deny and require must be set")
+	}
+	src := def.Deny + def.Require
+	expr, err := policy.Compile(src, vars)
+	if err != nil {
+		return nil, err
+	}
+	category, err := parseCategory(def.Category)
+	if err != nil {
+		return nil, err
+	}
+	return &policyRule{
+		id:       def.ID,
+		severity: sev,
+		message:  def.Message,
+		category: category,
+		docsURL:  def.DocsURL,
+		scope:    scope,
+		expr:     expr,
+		deny:     def.Deny != "",
+	}, nil
+}
+
+// ID returns the configured rule identifier.
+func (r *policyRule) ID() string { return r.id }
+
+// Metadata describes the rule from its declaration.
+func (r *policyRule) Metadata() engine.Metadata {
+	return engine.Metadata{Title: r.message, Description: r.expr.String(), Category: r.category, Tags: []string{"policy"}, Severity: r.severity, DocsURL: r.docsURL}
+}
+
+// Check evaluates the policy once per document, stage or instruction.
+//
+// Stage findings point at the stage's FROM line and instruction findings at
+// the instruction; the instruction scope includes each FROM.
+func (r *policyRule) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	var findings []engine.Finding
+	if d == nil || d.AST == nil {
+		return findings, nil
+	}
+	doc := policy.Document(d)
+	vars := map[string]policy.Value{"document": doc, "trusted": r.trusted}
+	if r.scope == "document" {
+		return r.eval(vars, 0, findings)
+	}
+	stages, _ := doc["stages"].([]policy.Value)
+	for i, st := range d.Stages {
+		stage := stages[i].(map[string]policy.Value)
+		vars["stage"], vars["from"] = stage, stage["from"]
+		if r.scope == "stage" {
+			var err error
+			if findings, err = r.eval(vars, st.Node.StartLine, findings); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		for _, n := range append([]*parser.Node{st.Node}, st.Instructions...) {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			vars["instruction"] = policy.Instruction(d, n)
+			var err error
+			if findings, err = r.eval(vars, n.StartLine, findings); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return findings, nil
+}
+
+// eval evaluates the expression and appends a finding at line on a violation.
+func (r *policyRule) eval(vars map[string]policy.Value, line int, findings []engine.Finding) ([]engine.Finding, error) {
+	ok, err := r.expr.EvalBool(vars)
+	if err != nil {
+		return nil, fmt.Errorf("policy %s: %w", r.id, err)
+	}
+	if ok == r.deny {
+		findings = append(findings, engine.Finding{RuleID: r.id, Message: r.message, Line: line, Severity: r.severity})
+	}
+	return findings, nil
+}
@@ -0,0 +1,98 @@
+// file: internal/rules/policy_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/config"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// checkPolicy compiles def and returns the lines it reports for src.
+func checkPolicy(t *testing.T, def config.Policy, trusted []string, src string) []int {
+	t.Helper()
+	res, err := parser.Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	doc, err := ir.BuildDocument("Dockerfile", res.AST)
+	if err != nil {
+		t.Fatalf("build doc: %v", err)
+	}
+	def.ID, def.Message = "ACME200", "policy violation"
+	rules, err := NewPolicyRules([]config.Policy{def}, trusted)
+	if err != nil {
+		t.Fatalf("compile: %v", err)
+	}
+	findings, err := rules[0].Check(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("check failed: %v", err)
+	}
+	var lines []int
+	for _, f := range findings {
+		lines = append(lines, f.Line)
+	}
+	return lines
+}
+
+// TestIntegrationPolicyRules verifies deny and require policies in each scope.
+func TestIntegrationPolicyRules(t *testing.T) {
+	src := "FROM ghcr.io/acme/go@sha256:abc AS build\n" +
+		"RUN curl -k https://x\n" +
+		"FROM docker.io/library/alpine:3.20\n" +
+		"COPY --from=build /app /app\n"
+	cases := []struct {
+		name string
+		def  config.Policy
+		want []int
+	}{
+		{"final stage without user", config.Policy{Deny: `stage.isFinal && !stage.has("USER")`}, []int{3}},
+		{"trusted pinned base", config.Policy{Require: `from.registry in trusted && from.digest != ""`}, []int{3}},
+		{"instruction scope", config.Policy{For: "instruction", Deny: `instruction.commands.exists(c, c.name == "curl" && "-k" in c.args)`}, []int{2}},
+		{"document scope", config.Policy{For: "document", Require: `len(document.stages) < 2`}, []int{0}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := checkPolicy(t, tc.def, []string{"ghcr.io"}, src)
+			if len(got) != len(tc.want) || (len(got) > 0 && got[0] != tc.want[0]) {
+				t.Fatalf("expected lines %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+// TestNewPolicyRulesErrors verifies validation of policy declarations.
+func TestNewPolicyRulesErrors(t *testing.T) {
+	cases := map[string][]config.Policy{
+		"missing id":         {{Message: "m", Deny: "true"}},
+		"missing message":    {{ID: "P1", Deny: "true"}},
+		"duplicate id":       {{ID: "P1", Message: "m", Deny: "true"}, {ID: "P1", Message: "m", Deny: "true"}},
+		"unknown scope":      {{ID: "P1", Message: "m", For: "layer", Deny: "true"}},
+		"exactly one":        {{ID: "P1", Message: "m"}},
+		"undefined variable": {{ID: "P1", Message: "m", For: "document", Deny: "stage.isFinal"}},
+		"unknown severity":   {{ID: "P1", Message: "m", Severity: "fatal", Deny: "true"}},
+	}
+	for want, defs := range cases {
+		if _, err := NewPolicyRules(defs, nil); err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("expected %q error, got %v", want, err)
+		}
+	}
+}
+
+// TestIntegrationPolicyRuntimeError verifies evaluation errors name the policy.
+func TestIntegrationPolicyRuntimeError(t *testing.T) {
+	res, _ := parser.Parse(strings.NewReader("FROM alpine:3.20\n"))
+	doc, _ := ir.BuildDocument("Dockerfile", res.AST)
+	rules, err := NewPolicyRules([]config.Policy{{ID: "P1", Message: "m", Deny: `stage.name`}}, nil)
+	if err != nil {
+		t.Fatalf("compile: %v", err)
+	}
+	if _, err := rules[0].Check(context.Background(), doc); err == nil || !strings.Contains(err.Error(), "policy P1: expression must yield bool") {
+		t.Fatalf("expected runtime error, got %v", err)
+	}
+}
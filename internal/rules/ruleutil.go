@@ -4,38 +4,41 @@
 package rules
 
 import (
-	"github.com/google/shlex"
-	"github.com/moby/buildkit/frontend/dockerfile/parser"
 	"strings"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
 )
 
-// splitRunSegments splits a RUN instruction into command segments separated by shell connectors.
-func splitRunSegments(n *parser.Node) [][]string {
-	if n == nil || n.Next == nil {
-		return nil
-	}
-	if n.Attributes != nil && n.Attributes["json"] {
-		return [][]string{{strings.ToLower(n.Next.Value)}}
-	}
-	tokens, err := shlex.Split(n.Next.Value)
-	if err != nil {
-		return nil
-	}
-	var segs [][]string
-	var cur []string
-	for _, tok := range tokens {
-		switch tok {
-		case "&&", "||", ";", "|":
-			if len(cur) > 0 {
-				segs = append(segs, lowerSlice(cur))
-				cur = nil
-			}
-		default:
-			cur = append(cur, tok)
+// runSegment is a lowercase RUN command argument vector with its Dockerfile line.
+type runSegment struct {
+	Args []string
+	Line int
+}
+
+// runSegments returns the lowercase argument vector of each command in a RUN
+// instruction together with the Dockerfile line on which it starts.
+//
+// Wrapper commands such as sudo and env are stripped so that each segment
+// starts with the command that is ultimately executed. Commands in heredoc
+// scripts report the heredoc line they appear on. Parsing is memoized on d;
+// d may be nil.
+func runSegments(d *ir.Document, n *parser.Node) []runSegment {
+	var segs []runSegment
+	for _, c := range d.RunCommands(n) {
+		if argv := unwrapCommand(c.Argv); len(argv) > 0 {
+			segs = append(segs, runSegment{Args: lowerSlice(argv), Line: c.Line})
 		}
 	}
-	if len(cur) > 0 {
-		segs = append(segs, lowerSlice(cur))
+	return segs
+}
+
+// splitRunSegments returns the lowercase argument vector of each command in a RUN instruction.
+func splitRunSegments(d *ir.Document, n *parser.Node) [][]string {
+	var segs [][]string
+	for _, seg := range runSegments(d, n) {
+		segs = append(segs, seg.Args)
 	}
 	return segs
 }
@@ -48,3 +51,9 @@ func lowerSlice(in []string) []string {
 	}
 	return out
 }
+
+// docsBaseURL is the location of the rule documentation.
+const docsBaseURL = "https://github.com/asymmetric-effort/docker-lint/blob/main/docs/rules/"
+
+// docsURL returns the documentation URL of rule id.
+func docsURL(id string) string { return docsBaseURL + id + ".md" }
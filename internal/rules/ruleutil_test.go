@@ -12,13 +12,13 @@ import (
 // TestSplitRunSegments covers JSON, shell, and error scenarios.
 func TestSplitRunSegments(t *testing.T) {
 	t.Run("nil", func(t *testing.T) {
-		if splitRunSegments(nil) != nil {
+		if splitRunSegments(nil, nil) != nil {
 			t.Fatalf("expected nil for nil node")
 		}
 	})
 	t.Run("json", func(t *testing.T) {
 		n := &parser.Node{Attributes: map[string]bool{"json": true}, Next: &parser.Node{Value: "CMD"}}
-		got := splitRunSegments(n)
+		got := splitRunSegments(nil, n)
 		want := [][]string{{"cmd"}}
 		if !reflect.DeepEqual(got, want) {
 			t.Fatalf("got %v want %v", got, want)
@@ -26,16 +26,18 @@ func TestSplitRunSegments(t *testing.T) {
 	})
 	t.Run("shell", func(t *testing.T) {
 		n := &parser.Node{Next: &parser.Node{Value: "echo hi && ls"}}
-		got := splitRunSegments(n)
+		got := splitRunSegments(nil, n)
 		want := [][]string{{"echo", "hi"}, {"ls"}}
 		if !reflect.DeepEqual(got, want) {
 			t.Fatalf("got %v want %v", got, want)
 		}
 	})
-	t.Run("bad shell", func(t *testing.T) {
+	t.Run("malformed shell", func(t *testing.T) {
 		n := &parser.Node{Next: &parser.Node{Value: "echo 'unterminated"}}
-		if splitRunSegments(n) != nil {
-			t.Fatalf("expected nil on parse error")
+		got := splitRunSegments(nil, n)
+		want := [][]string{{"echo", "unterminated"}}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("expected partial result on parse error, got %v", got)
 		}
 	})
 }
@@ -5,55 +5,200 @@ package rules
 import (
 	"strings"
 
-	"github.com/google/shlex"
 	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
 )
 
 // extractCommands returns command names invoked in a RUN instruction.
 //
-// extractCommands inspects the RUN node and returns the list of command
-// names, respecting shell parsing and handling JSON-form RUN variants.
-func extractCommands(n *parser.Node) []string {
-	if n == nil || n.Next == nil {
-		return nil
-	}
-	if n.Attributes != nil && n.Attributes["json"] {
-		return []string{strings.ToLower(n.Next.Value)}
+// extractCommands inspects the RUN node and returns the lowercase list of
+// command names, respecting shell parsing and handling JSON-form RUN variants.
+// Parsing is memoized on d; d may be nil.
+func extractCommands(d *ir.Document, n *parser.Node) []string {
+	var cmds []string
+	for _, c := range d.RunCommands(n) {
+		cmds = append(cmds, strings.ToLower(c.Argv[0]))
 	}
-	tokens, err := shlex.Split(n.Next.Value)
-	if err != nil {
-		return nil
+	return cmds
+}
+
+// commandWrappers lists commands that run their arguments as another command.
+var commandWrappers = map[string]struct{}{"sudo": {}, "env": {}, "exec": {}, "nohup": {}, "time": {}}
+
+// sudoArgOptions lists sudo options that consume the following argument.
+var sudoArgOptions = map[string]struct{}{"-u": {}, "-g": {}, "-C": {}, "-D": {}, "-h": {}, "-p": {}, "-r": {}, "-t": {}, "-U": {}}
+
+// unwrapCommand strips wrapper commands such as sudo and env, with their options
+// and assignments, returning the argument vector of the wrapped command.
+func unwrapCommand(argv []string) []string {
+	for len(argv) > 0 {
+		wrapper := strings.ToLower(argv[0])
+		if _, ok := commandWrappers[wrapper]; !ok {
+			return argv
+		}
+		argv = argv[1:]
+		for len(argv) > 0 && (strings.HasPrefix(argv[0], "-") || strings.Contains(argv[0], "=")) {
+			if _, ok := sudoArgOptions[argv[0]]; ok && wrapper == "sudo" && len(argv) > 1 {
+				argv = argv[1:]
+			}
+			argv = argv[1:]
+		}
 	}
-	return commandNames(tokens)
+	return argv
 }
 
-// commandNames identifies command boundaries within shell tokens.
+// curlValueOptions lists curl short options that consume a value.
+const curlValueOptions = "AbcCdDeEFHKmQrTuUwxXyYz"
+
+// downloadsToStdout reports whether argv downloads with curl or wget and
+// writes the response to standard output.
 //
-// commandNames returns the ordered list of commands invoked within the
-// tokenized shell statement, tracking boundaries across common shell
-// connectors like && and ||.
-func commandNames(tokens []string) []string {
-	var cmds []string
-	expect := true
-	for _, tok := range tokens {
-		if expect {
-			cmds = append(cmds, strings.ToLower(tok))
-			expect = false
-			continue
+// curl writes to standard output unless -o, -O or their long forms name a
+// file; wget only does with -O - or -O /dev/stdout.
+func downloadsToStdout(argv []string) bool {
+	if len(argv) == 0 {
+		return false
+	}
+	switch commandName(argv) {
+	case "curl":
+		return curlToStdout(argv[1:])
+	case "wget":
+		return wgetToStdout(argv[1:])
+	}
+	return false
+}
+
+// curlToStdout reports whether curl arguments leave the output on standard output.
+func curlToStdout(args []string) bool {
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		switch {
+		case a == "--output" || a == "-o":
+			if i+1 >= len(args) || args[i+1] != "-" {
+				return false
+			}
+			i++
+		case strings.HasPrefix(a, "--output="):
+			if a != "--output=-" {
+				return false
+			}
+		case a == "--remote-name" || a == "--remote-name-all":
+			return false
+		case strings.HasPrefix(a, "-") && !strings.HasPrefix(a, "--"):
+			for j := 1; j < len(a); j++ {
+				c := a[j]
+				if c == 'O' {
+					return false
+				}
+				if c == 'o' {
+					val := a[j+1:]
+					if val == "" && i+1 < len(args) {
+						i++
+						val = args[i]
+					}
+					if val != "-" {
+						return false
+					}
+					break
+				}
+				if strings.IndexByte(curlValueOptions, c) >= 0 {
+					if j == len(a)-1 {
+						i++
+					}
+					break
+				}
+			}
 		}
-		switch tok {
-		case "&&", "||", "|", ";":
-			expect = true
+	}
+	return true
+}
+
+// wgetToStdout reports whether wget arguments direct the download to standard output.
+func wgetToStdout(args []string) bool {
+	isStdout := func(v string) bool { return v == "-" || v == "/dev/stdout" }
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		switch {
+		case a == "--output-document":
+			if i+1 < len(args) && isStdout(args[i+1]) {
+				return true
+			}
+			i++
+		case strings.HasPrefix(a, "--output-document="):
+			if isStdout(strings.TrimPrefix(a, "--output-document=")) {
+				return true
+			}
+		case strings.HasPrefix(a, "-") && !strings.HasPrefix(a, "--"):
+			if j := strings.IndexByte(a, 'O'); j > 0 {
+				val := a[j+1:]
+				if val == "" && i+1 < len(args) {
+					i++
+					val = args[i]
+				}
+				if isStdout(val) {
+					return true
+				}
+			}
 		}
 	}
-	return cmds
+	return false
 }
 
-// lowerSegments returns a lowercase copy of each segment.
-func lowerSegments(segs [][]string) [][]string {
-	out := make([][]string, len(segs))
-	for i, seg := range segs {
-		out[i] = lowerSlice(seg)
+// interpreters lists the commands that execute a program read from a file or standard input.
+var interpreters = map[string]struct{}{
+	"sh": {}, "bash": {}, "dash": {}, "ash": {}, "zsh": {}, "ksh": {},
+	"python": {}, "python2": {}, "python3": {}, "perl": {}, "node": {}, "nodejs": {},
+}
+
+// isInterpreter reports whether name, a lowercase base name, is a script interpreter.
+// Versioned Python executables such as python3.12 are included.
+func isInterpreter(name string) bool {
+	if _, ok := interpreters[name]; ok {
+		return true
+	}
+	return strings.HasPrefix(name, "python3.") || strings.HasPrefix(name, "python2.")
+}
+
+// interpreterReadsStdin reports whether the interpreter invoked by argv reads
+// its program from standard input, that is, it is given neither a script file
+// nor inline code such as sh -c, python -c or -m, perl -e or node -e.
+func interpreterReadsStdin(argv []string) bool {
+	name := commandName(argv)
+	shell := !strings.HasPrefix(name, "python") && name != "perl" && name != "node" && name != "nodejs"
+	args := argv[1:]
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		switch {
+		case a == "-" || (shell && a == "-s"):
+			return true
+		case a == "--":
+			return i+1 == len(args)
+		case !strings.HasPrefix(a, "-") && !(shell && strings.HasPrefix(a, "+")):
+			return false
+		case shell:
+			if !strings.HasPrefix(a, "--") && strings.ContainsRune(a[1:], 'c') {
+				return false
+			}
+			if a == "-o" || a == "+o" {
+				i++
+			}
+		case strings.HasPrefix(name, "python"):
+			if a == "-c" || a == "-m" {
+				return false
+			}
+			if a == "-W" || a == "-X" {
+				i++
+			}
+		case name == "perl":
+			if !strings.HasPrefix(a, "--") && strings.ContainsAny(a[1:], "eE") {
+				return false
+			}
+		default:
+			if a == "-e" || a == "--eval" || a == "-p" || a == "--print" || strings.HasPrefix(a, "--eval=") {
+				return false
+			}
+		}
 	}
-	return out
+	return true
 }
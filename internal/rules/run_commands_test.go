@@ -4,6 +4,7 @@ package rules
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/moby/buildkit/frontend/dockerfile/parser"
@@ -12,13 +13,13 @@ import (
 // TestExtractCommands exercises extractCommands across JSON and shell forms.
 func TestExtractCommands(t *testing.T) {
 	t.Run("nil", func(t *testing.T) {
-		if extractCommands(nil) != nil {
+		if extractCommands(nil, nil) != nil {
 			t.Fatalf("expected nil for nil node")
 		}
 	})
 	t.Run("json", func(t *testing.T) {
 		n := &parser.Node{Attributes: map[string]bool{"json": true}, Next: &parser.Node{Value: "CMD"}}
-		got := extractCommands(n)
+		got := extractCommands(nil, n)
 		want := []string{"cmd"}
 		if !reflect.DeepEqual(got, want) {
 			t.Fatalf("got %v want %v", got, want)
@@ -26,35 +27,93 @@ func TestExtractCommands(t *testing.T) {
 	})
 	t.Run("shell", func(t *testing.T) {
 		n := &parser.Node{Next: &parser.Node{Value: "echo hi && ls"}}
-		got := extractCommands(n)
+		got := extractCommands(nil, n)
 		want := []string{"echo", "ls"}
 		if !reflect.DeepEqual(got, want) {
 			t.Fatalf("got %v want %v", got, want)
 		}
 	})
-	t.Run("bad shell", func(t *testing.T) {
+	t.Run("malformed shell", func(t *testing.T) {
 		n := &parser.Node{Next: &parser.Node{Value: "echo 'unterminated"}}
-		if extractCommands(n) != nil {
-			t.Fatalf("expected nil on parse error")
+		got := extractCommands(nil, n)
+		want := []string{"echo"}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("expected partial result on parse error, got %v", got)
 		}
 	})
 }
 
-// TestCommandNames verifies command extraction with shell connectors.
-func TestCommandNames(t *testing.T) {
-	tokens := []string{"echo", "hi", "&&", "ls", "||", "cat", "|", "grep", ";", "sed"}
-	want := []string{"echo", "ls", "cat", "grep", "sed"}
-	if got := commandNames(tokens); !reflect.DeepEqual(got, want) {
-		t.Fatalf("got %v want %v", got, want)
+// TestUnwrapCommand verifies wrapper commands are stripped.
+func TestUnwrapCommand(t *testing.T) {
+	cases := map[string][]string{
+		"sudo -E -u app apt-get install x": {"apt-get", "install", "x"},
+		"env -i PATH=/bin apk add y":       {"apk", "add", "y"},
+		"nohup time pip install z":         {"pip", "install", "z"},
+		"apt-get update":                   {"apt-get", "update"},
+		"sudo":                             {},
+	}
+	for in, want := range cases {
+		if got := unwrapCommand(strings.Fields(in)); !reflect.DeepEqual(got, want) {
+			t.Fatalf("%q: got %v want %v", in, got, want)
+		}
+	}
+}
+
+// TestDownloadsToStdout covers curl and wget output options.
+func TestDownloadsToStdout(t *testing.T) {
+	cases := map[string]bool{
+		"curl https://x":                     true,
+		"curl -fsSL https://x":               true,
+		"curl -o - https://x":                true,
+		"curl -fsSLo- https://x":             true,
+		"curl -H X-o:y https://x":            true,
+		"curl -o out https://x":              false,
+		"curl -fsSLo out https://x":          false,
+		"curl -O https://x":                  false,
+		"curl --output=out https://x":        false,
+		"curl --remote-name https://x":       false,
+		"wget https://x":                     false,
+		"wget -O out https://x":              false,
+		"wget -O- https://x":                 true,
+		"wget -qO - https://x":               true,
+		"wget -O /dev/stdout https://x":      true,
+		"wget --output-document=- https://x": true,
+		"/usr/bin/curl -s https://x":         true,
+		"git clone https://x":                false,
+	}
+	for src, want := range cases {
+		if got := downloadsToStdout(strings.Fields(src)); got != want {
+			t.Errorf("%q: got %v want %v", src, got, want)
+		}
 	}
 }
 
-// TestLowerSegments confirms all segments are lowercased.
-func TestLowerSegments(t *testing.T) {
-	segs := [][]string{{"Echo", "Hi"}, {"LS"}}
-	got := lowerSegments(segs)
-	want := [][]string{{"echo", "hi"}, {"ls"}}
-	if !reflect.DeepEqual(got, want) {
-		t.Fatalf("got %v want %v", got, want)
+// TestInterpreterReadsStdin distinguishes programs read from standard input.
+func TestInterpreterReadsStdin(t *testing.T) {
+	cases := map[string]bool{
+		"sh":                   true,
+		"bash -s -- --yes":     true,
+		"bash -ex":             true,
+		"bash -o pipefail":     true,
+		"bash -c true":         false,
+		"sh -ec true":          false,
+		"sh install.sh":        false,
+		"bash -- install.sh":   false,
+		"python3":              true,
+		"python3 -":            true,
+		"python3 -u":           true,
+		"python3 -c print(1)":  false,
+		"python3 -m json.tool": false,
+		"perl":                 true,
+		"perl -ne print":       false,
+		"node":                 true,
+		"node -e 1":            false,
+		"node --eval=1":        false,
+		"node script.js":       false,
+	}
+	for src, want := range cases {
+		if got := interpreterReadsStdin(strings.Fields(src)); got != want {
+			t.Errorf("%q: got %v want %v", src, got, want)
+		}
 	}
 }
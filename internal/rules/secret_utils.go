@@ -0,0 +1,166 @@
+// file: internal/rules/secret_utils.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// secretAdvice is appended to secret findings.
+const secretAdvice = "use RUN --mount=type=secret instead"
+
+// sensitiveNamePattern matches variable names that conventionally hold credentials.
+var sensitiveNamePattern = regexp.MustCompile(`(?:^|_)(?:PASSWORD|PASSWD|PASS|SECRET|TOKEN|API_?KEY|ACCESS_?KEY|PRIVATE_?KEY|CREDENTIALS?)(?:_|$)`)
+
+// nonSecretNameSuffixes mark names that point at a credential rather than hold it.
+var nonSecretNameSuffixes = []string{"_FILE", "_PATH", "_DIR", "_URL", "_ENDPOINT"}
+
+// sensitiveName reports whether the variable name suggests that its value is a secret.
+func sensitiveName(name string) bool {
+	name = strings.ToUpper(name)
+	if strings.Contains(name, "PUBLIC") {
+		return false
+	}
+	for _, s := range nonSecretNameSuffixes {
+		if strings.HasSuffix(name, s) {
+			return false
+		}
+	}
+	return sensitiveNamePattern.MatchString(name)
+}
+
+// secretPattern recognizes a known credential format, described with its
+// article for use in messages. When the expression has a capture group, the
+// group is the secret and the rest is context.
+type secretPattern struct {
+	kind string
+	re   *regexp.Regexp
+}
+
+// secretPatterns lists the known credential formats.
+var secretPatterns = []secretPattern{
+	{"an AWS access key ID", regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"a GitHub token", regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})`)},
+	{"a private key", regexp.MustCompile(`-----BEGIN (?:[A-Z0-9]+ )*PRIVATE KEY(?: BLOCK)?-----`)},
+	{"a JSON Web Token", regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{5,}\.eyJ[A-Za-z0-9_-]{5,}\.[A-Za-z0-9_-]{10,}`)},
+	{"an Authorization header credential", regexp.MustCompile(`(?i)authorization:\s*(?:bearer|basic|token)\s+([^\s"'$\\]+)`)},
+}
+
+// highEntropyToken matches candidate tokens for the entropy check.
+var highEntropyToken = regexp.MustCompile(`[A-Za-z0-9+/_-]{20,}={0,2}`)
+
+// minSecretEntropy is the Shannon entropy, in bits per character, from which
+// a mixed-case alphanumeric token is considered random.
+const minSecretEntropy = 4.0
+
+// secretMatch is a secret found in instruction text.
+type secretMatch struct {
+	Kind  string
+	Value string
+}
+
+// findSecrets returns the first secret of each kind found in s.
+//
+// Known formats are reported by name; remaining tokens of at least twenty
+// characters mixing upper case, lower case and digits are reported when their
+// entropy suggests a random credential.
+func findSecrets(s string) []secretMatch {
+	var out []secretMatch
+	var spans [][]int
+	for _, p := range secretPatterns {
+		loc := p.re.FindStringSubmatchIndex(s)
+		if loc == nil {
+			continue
+		}
+		spans = append(spans, loc[:2])
+		start, end := loc[0], loc[1]
+		if len(loc) > 2 && loc[2] >= 0 {
+			start, end = loc[2], loc[3]
+		}
+		out = append(out, secretMatch{Kind: p.kind, Value: s[start:end]})
+	}
+	for _, loc := range highEntropyToken.FindAllStringIndex(s, -1) {
+		if overlaps(spans, loc) {
+			continue
+		}
+		tok := s[loc[0]:loc[1]]
+		if strings.HasPrefix(tok, "/") || !mixedAlphanumeric(tok) || shannonEntropy(tok) < minSecretEntropy {
+			continue
+		}
+		out = append(out, secretMatch{Kind: "a high-entropy string", Value: tok})
+		break
+	}
+	return out
+}
+
+// overlaps reports whether loc intersects any of spans.
+func overlaps(spans [][]int, loc []int) bool {
+	for _, sp := range spans {
+		if loc[0] < sp[1] && sp[0] < loc[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// mixedAlphanumeric reports whether s contains upper case, lower case and digits.
+func mixedAlphanumeric(s string) bool {
+	var upper, lower, digit bool
+	for _, r := range s {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		}
+	}
+	return upper && lower && digit
+}
+
+// shannonEntropy returns the Shannon entropy of s in bits per character.
+func shannonEntropy(s string) float64 {
+	counts := map[rune]int{}
+	n := 0
+	for _, r := range s {
+		counts[r]++
+		n++
+	}
+	var h float64
+	for _, c := range counts {
+		p := float64(c) / float64(n)
+		h -= p * math.Log2(p)
+	}
+	return h
+}
+
+// redact masks a secret so that findings never reveal it, keeping a short
+// prefix of long values to help locate them.
+func redact(s string) string {
+	if len(s) < 12 {
+		return "****"
+	}
+	return s[:4] + "****"
+}
+
+// redactSource masks the userinfo of a URL source, such as the token in
+// https://oauth2:<token>@host/repo.git, leaving the rest as written.
+func redactSource(src string) string {
+	scheme := strings.Index(src, "://")
+	if scheme < 0 {
+		return src
+	}
+	host := src[scheme+3:]
+	if i := strings.IndexAny(host, "/?#"); i >= 0 {
+		host = host[:i]
+	}
+	at := strings.LastIndex(host, "@")
+	if at < 0 {
+		return src
+	}
+	return src[:scheme+3] + "****" + src[scheme+3+at:]
+}
@@ -6,6 +6,8 @@ import (
 	"strings"
 
 	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
 )
 
 // copyFromFlag extracts the value of --from from a COPY node.
@@ -32,3 +34,16 @@ func stageAlias(n *parser.Node) string {
 	}
 	return ""
 }
+
+// stageLineage returns st followed by the stages it inherits from via FROM.
+func stageLineage(d *ir.Document, st *ir.Stage) []*ir.Stage {
+	var out []*ir.Stage
+	for st != nil {
+		out = append(out, st)
+		if st.Parent < 0 || st.Parent >= len(d.Stages) {
+			break
+		}
+		st = d.Stages[st.Parent]
+	}
+	return out
+}
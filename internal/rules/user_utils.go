@@ -0,0 +1,100 @@
+// file: internal/rules/user_utils.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package rules
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// userSwitch is a USER instruction with its value expanded where possible.
+//
+// Values that reference unknown build variables keep the $ reference.
+type userSwitch struct {
+	User string
+	Node *parser.Node
+}
+
+// expandUser returns the value of USER instruction n with build variables
+// substituted, or as written when a variable has no known value.
+func expandUser(d *ir.Document, n *parser.Node) string {
+	if u, ok := d.Expand(n, n.Next.Value); ok {
+		return u
+	}
+	return n.Next.Value
+}
+
+// stageUsers returns the USER instructions in effect for st, starting with
+// those inherited through FROM <stage> and ending with the stage's own.
+func stageUsers(d *ir.Document, st *ir.Stage) []userSwitch {
+	var out []userSwitch
+	lineage := stageLineage(d, st)
+	for i := len(lineage) - 1; i >= 0; i-- {
+		for _, n := range lineage[i].Instructions {
+			if strings.EqualFold(n.Value, "user") && n.Next != nil {
+				out = append(out, userSwitch{User: expandUser(d, n), Node: n})
+			}
+		}
+	}
+	return out
+}
+
+// userName returns the user part of a USER value of the form user[:group].
+func userName(u string) string {
+	name, _, _ := strings.Cut(u, ":")
+	return name
+}
+
+// isNumericUser reports whether the user part of a USER value is a numeric UID.
+func isNumericUser(u string) bool {
+	name := userName(u)
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// systemUsers lists accounts that common base images provide without useradd.
+var systemUsers = map[string]struct{}{
+	"root": {}, "daemon": {}, "bin": {}, "sys": {}, "adm": {}, "lp": {}, "sync": {}, "shutdown": {},
+	"halt": {}, "mail": {}, "news": {}, "uucp": {}, "operator": {}, "games": {}, "man": {}, "proxy": {},
+	"www-data": {}, "backup": {}, "list": {}, "irc": {}, "ftp": {}, "guest": {}, "nobody": {},
+	"nonroot": {}, "node": {},
+}
+
+// userCreators lists commands that add accounts to the image.
+var userCreators = map[string]struct{}{"useradd": {}, "adduser": {}}
+
+// createsUser reports whether instruction n creates the account name, either
+// by running useradd or adduser with name as an argument or by writing
+// /etc/passwd.
+func createsUser(d *ir.Document, n *parser.Node, name string) bool {
+	switch strings.ToLower(n.Value) {
+	case "run":
+		if strings.Contains(instructionArgs(n), "/etc/passwd") {
+			return true
+		}
+		for _, c := range d.RunCommands(n) {
+			argv := unwrapCommand(c.Argv)
+			if len(argv) == 0 {
+				continue
+			}
+			if _, ok := userCreators[commandName(argv)]; ok && slices.Contains(argv[1:], name) {
+				return true
+			}
+		}
+	case "copy", "add":
+		args := collectArgs(n)
+		return len(args) > 0 && strings.HasPrefix(args[len(args)-1], "/etc/passwd")
+	}
+	return false
+}
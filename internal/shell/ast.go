@@ -0,0 +1,163 @@
+// file: internal/shell/ast.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package shell
+
+// Node is implemented by every element of the command AST.
+type Node interface {
+	node()
+}
+
+// Command is a pipeline element: a simple command or a compound command.
+type Command interface {
+	Node
+	command()
+}
+
+// List is a sequence of and-or lists separated by `;`, `&` or newlines.
+type List struct {
+	Items []*AndOr
+}
+
+// AndOr is a chain of pipelines joined by `&&` or `||`.
+//
+// Ops[i] is the operator between Pipelines[i] and Pipelines[i+1]. Background
+// reports whether the chain was terminated by `&`.
+type AndOr struct {
+	Pipelines  []*Pipeline
+	Ops        []string
+	Background bool
+}
+
+// Pipeline is a sequence of commands joined by `|` or `|&`.
+type Pipeline struct {
+	Negated bool
+	Cmds    []Command
+}
+
+// Word is a shell word.
+//
+// Raw holds the source text. Value holds the text after quote removal, with
+// parameter expansions and command substitutions left unexpanded. Quoted
+// reports whether any part of the word was quoted or escaped. Subs holds the
+// parsed bodies of `$( )` and backtick command substitutions and of `<( )` and
+// `>( )` process substitutions within the word.
+type Word struct {
+	Raw    string
+	Value  string
+	Quoted bool
+	Subs   []*List
+}
+
+// Redirect is an I/O redirection such as `2>&1`, `> file` or `<<EOF`.
+//
+// N is the optional file descriptor prefix. For here-documents, Heredoc holds
+// the document body and Target the delimiter word.
+type Redirect struct {
+	N       string
+	Op      string
+	Target  *Word
+	Heredoc string
+}
+
+// SimpleCommand is a command with optional variable assignments and redirections.
+//
+// Line is the 1-based line of the script on which the command starts.
+type SimpleCommand struct {
+	Assigns []*Word
+	Args    []*Word
+	Redirs  []*Redirect
+	Line    int
+}
+
+// Subshell is a `( list )` compound command.
+type Subshell struct {
+	Body   *List
+	Redirs []*Redirect
+}
+
+// Group is a `{ list; }` compound command.
+type Group struct {
+	Body   *List
+	Redirs []*Redirect
+}
+
+// CondBlock is one `if`/`elif` condition with its body.
+type CondBlock struct {
+	Cond *List
+	Body *List
+}
+
+// IfClause is an `if ... then ... [elif ...] [else ...] fi` compound command.
+type IfClause struct {
+	Blocks []CondBlock
+	Else   *List
+	Redirs []*Redirect
+}
+
+// Loop is a `for`, `while` or `until` compound command.
+//
+// For `for` loops, Var and Items hold the loop variable and word list and
+// Cond is nil. For `while` and `until` loops, Cond holds the condition.
+type Loop struct {
+	Kind   string
+	Var    string
+	Items  []*Word
+	Cond   *List
+	Body   *List
+	Redirs []*Redirect
+}
+
+// CaseItem is a single pattern list and body of a case clause.
+type CaseItem struct {
+	Patterns []*Word
+	Body     *List
+}
+
+// CaseClause is a `case word in ... esac` compound command.
+type CaseClause struct {
+	Word   *Word
+	Items  []CaseItem
+	Redirs []*Redirect
+}
+
+// FuncDecl is a function definition such as `name() { ...; }`.
+type FuncDecl struct {
+	Name string
+	Body Command
+}
+
+func (*List) node()          {}
+func (*AndOr) node()         {}
+func (*Pipeline) node()      {}
+func (*SimpleCommand) node() {}
+func (*Subshell) node()      {}
+func (*Group) node()         {}
+func (*IfClause) node()      {}
+func (*Loop) node()          {}
+func (*CaseClause) node()    {}
+func (*FuncDecl) node()      {}
+
+func (*SimpleCommand) command() {}
+func (*Subshell) command()      {}
+func (*Group) command()         {}
+func (*IfClause) command()      {}
+func (*Loop) command()          {}
+func (*CaseClause) command()    {}
+func (*FuncDecl) command()      {}
+
+// Argv returns the values of the command's arguments, starting with the command name.
+func (c *SimpleCommand) Argv() []string {
+	out := make([]string, len(c.Args))
+	for i, w := range c.Args {
+		out[i] = w.Value
+	}
+	return out
+}
+
+// Name returns the command name, or an empty string for assignment-only commands.
+func (c *SimpleCommand) Name() string {
+	if len(c.Args) == 0 {
+		return ""
+	}
+	return c.Args[0].Value
+}
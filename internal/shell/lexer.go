@@ -0,0 +1,381 @@
+// file: internal/shell/lexer.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package shell
+
+import (
+	"fmt"
+	"strings"
+)
+
+// tokenKind classifies lexer tokens.
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokWord
+	tokOp
+	tokRedir
+	tokNewline
+)
+
+// token is a lexical unit of a shell script.
+//
+// For tokRedir, n holds the file descriptor prefix and val the operator.
+type token struct {
+	kind tokenKind
+	val  string
+	n    string
+	word *Word
+	line int
+}
+
+// SyntaxError describes a malformed shell script.
+//
+// Parse still returns the commands recognized before and after the error.
+type SyntaxError struct {
+	Line int
+	Msg  string
+}
+
+// Error implements the error interface.
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("shell: line %d: %s", e.Line, e.Msg)
+}
+
+// lexer converts shell source into tokens.
+type lexer struct {
+	src  string
+	pos  int
+	line int
+	errs []*SyntaxError
+	// pending holds here-document redirects whose bodies start after the next newline.
+	pending []*Redirect
+}
+
+// redirOps lists redirection operators, longest first.
+var redirOps = []string{"<<<", "<<-", "&>>", "<<", ">>", "<&", ">&", "<>", ">|", "&>", "<", ">"}
+
+// ctrlOps lists control operators, longest first.
+var ctrlOps = []string{"&&", "||", ";;", "|&", ";", "&", "|", "(", ")"}
+
+// errorf records a syntax error at the current line.
+func (l *lexer) errorf(format string, args ...any) {
+	l.errs = append(l.errs, &SyntaxError{Line: l.line, Msg: fmt.Sprintf(format, args...)})
+}
+
+// next returns the next token.
+func (l *lexer) next() token {
+	l.skipBlanks()
+	if l.pos >= len(l.src) {
+		return token{kind: tokEOF, line: l.line}
+	}
+	line := l.line
+	c := l.src[l.pos]
+	if c == '\n' {
+		l.pos++
+		l.line++
+		l.readHeredocs()
+		return token{kind: tokNewline, val: "\n", line: line}
+	}
+	if n := l.ioNumber(); n != "" {
+		for _, op := range redirOps {
+			if strings.HasPrefix(l.src[l.pos+len(n):], op) && op[0] != '&' {
+				l.pos += len(n) + len(op)
+				return token{kind: tokRedir, n: n, val: op, line: line}
+			}
+		}
+	}
+	if !l.procSubst() {
+		for _, op := range redirOps {
+			if strings.HasPrefix(l.src[l.pos:], op) {
+				l.pos += len(op)
+				return token{kind: tokRedir, val: op, line: line}
+			}
+		}
+	}
+	for _, op := range ctrlOps {
+		if strings.HasPrefix(l.src[l.pos:], op) {
+			l.pos += len(op)
+			return token{kind: tokOp, val: op, line: line}
+		}
+	}
+	w := l.word()
+	return token{kind: tokWord, val: w.Value, word: w, line: line}
+}
+
+// skipBlanks skips spaces, tabs, line continuations and comments.
+func (l *lexer) skipBlanks() {
+	for l.pos < len(l.src) {
+		switch c := l.src[l.pos]; {
+		case c == ' ' || c == '\t' || c == '\r':
+			l.pos++
+		case c == '\\' && l.pos+1 < len(l.src) && l.src[l.pos+1] == '\n':
+			l.pos += 2
+			l.line++
+		case c == '#':
+			for l.pos < len(l.src) && l.src[l.pos] != '\n' {
+				l.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// ioNumber returns a run of digits immediately followed by a redirection operator.
+func (l *lexer) ioNumber() string {
+	i := l.pos
+	for i < len(l.src) && l.src[i] >= '0' && l.src[i] <= '9' {
+		i++
+	}
+	if i == l.pos || i >= len(l.src) || (l.src[i] != '<' && l.src[i] != '>') {
+		return ""
+	}
+	return l.src[l.pos:i]
+}
+
+// isMeta reports whether c terminates an unquoted word.
+func isMeta(c byte) bool {
+	switch c {
+	case ' ', '\t', '\r', '\n', ';', '&', '|', '(', ')', '<', '>':
+		return true
+	}
+	return false
+}
+
+// word scans a single shell word, performing quote removal on its value.
+func (l *lexer) word() *Word {
+	start := l.pos
+	w := &Word{}
+	var val strings.Builder
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		if l.procSubst() {
+			l.subst(w, &val, 2)
+			continue
+		}
+		if isMeta(c) {
+			break
+		}
+		switch c {
+		case '\\':
+			w.Quoted = true
+			l.pos++
+			if l.pos < len(l.src) {
+				if l.src[l.pos] == '\n' {
+					l.line++
+				} else {
+					val.WriteByte(l.src[l.pos])
+				}
+				l.pos++
+			}
+		case '\'':
+			w.Quoted = true
+			end := strings.IndexByte(l.src[l.pos+1:], '\'')
+			if end < 0 {
+				l.errorf("unterminated single quote")
+				end = len(l.src) - l.pos - 1
+			}
+			body := l.src[l.pos+1 : l.pos+1+end]
+			l.line += strings.Count(body, "\n")
+			val.WriteString(body)
+			l.pos += end + 2
+			if l.pos > len(l.src) {
+				l.pos = len(l.src)
+			}
+		case '"':
+			w.Quoted = true
+			l.pos++
+			l.doubleQuoted(w, &val)
+		case '$':
+			l.dollar(w, &val)
+		case '`':
+			l.backtick(w, &val)
+		default:
+			val.WriteByte(c)
+			l.pos++
+		}
+	}
+	w.Raw = l.src[start:l.pos]
+	w.Value = val.String()
+	return w
+}
+
+// doubleQuoted scans the body of a double-quoted string up to the closing quote.
+func (l *lexer) doubleQuoted(w *Word, val *strings.Builder) {
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch c {
+		case '"':
+			l.pos++
+			return
+		case '\\':
+			if l.pos+1 < len(l.src) && strings.IndexByte("$`\"\\\n", l.src[l.pos+1]) >= 0 {
+				if l.src[l.pos+1] == '\n' {
+					l.line++
+				} else {
+					val.WriteByte(l.src[l.pos+1])
+				}
+				l.pos += 2
+				continue
+			}
+			val.WriteByte(c)
+			l.pos++
+		case '$':
+			l.dollar(w, val)
+		case '`':
+			l.backtick(w, val)
+		default:
+			if c == '\n' {
+				l.line++
+			}
+			val.WriteByte(c)
+			l.pos++
+		}
+	}
+	l.errorf("unterminated double quote")
+}
+
+// dollar scans a parameter expansion, arithmetic expansion or command substitution.
+//
+// The expansion's source text is kept verbatim in the word value.
+func (l *lexer) dollar(w *Word, val *strings.Builder) {
+	start := l.pos
+	switch {
+	case strings.HasPrefix(l.src[l.pos:], "$(("):
+		l.pos += 3
+		l.skipBalanced('(', ')', 2)
+	case strings.HasPrefix(l.src[l.pos:], "$("):
+		l.subst(w, val, 2)
+		return
+	case strings.HasPrefix(l.src[l.pos:], "${"):
+		l.pos += 2
+		l.skipBalanced('{', '}', 1)
+	default:
+		l.pos++
+	}
+	val.WriteString(l.src[start:l.pos])
+}
+
+// procSubst reports whether a `<( )` or `>( )` process substitution starts at the current position.
+func (l *lexer) procSubst() bool {
+	rest := l.src[l.pos:]
+	return strings.HasPrefix(rest, "<(") || strings.HasPrefix(rest, ">(")
+}
+
+// subst scans a parenthesized substitution whose body starts after an opener
+// of the given length, parsing the body into the word's Subs.
+//
+// The substitution's source text is kept verbatim in the word value.
+func (l *lexer) subst(w *Word, val *strings.Builder, opener int) {
+	start := l.pos
+	l.pos += opener
+	bodyStart := l.pos
+	l.skipBalanced('(', ')', 1)
+	end := l.pos - 1
+	if end < bodyStart {
+		end = bodyStart
+	}
+	w.Subs = append(w.Subs, l.sub(l.src[bodyStart:end]))
+	val.WriteString(l.src[start:l.pos])
+}
+
+// backtick scans a backtick command substitution.
+func (l *lexer) backtick(w *Word, val *strings.Builder) {
+	start := l.pos
+	l.pos++
+	var body strings.Builder
+	for l.pos < len(l.src) && l.src[l.pos] != '`' {
+		if l.src[l.pos] == '\\' && l.pos+1 < len(l.src) {
+			l.pos++
+		}
+		if l.src[l.pos] == '\n' {
+			l.line++
+		}
+		body.WriteByte(l.src[l.pos])
+		l.pos++
+	}
+	if l.pos >= len(l.src) {
+		l.errorf("unterminated backtick")
+	} else {
+		l.pos++
+	}
+	w.Subs = append(w.Subs, l.sub(body.String()))
+	val.WriteString(l.src[start:l.pos])
+}
+
+// sub parses the body of a command substitution, merging its errors.
+func (l *lexer) sub(src string) *List {
+	list, errs := parse(src, l.line)
+	l.errs = append(l.errs, errs...)
+	return list
+}
+
+// skipBalanced advances past depth unmatched close characters, honoring quotes.
+func (l *lexer) skipBalanced(open, close byte, depth int) {
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch {
+		case c == '\\':
+			l.pos++
+		case c == '\'':
+			if end := strings.IndexByte(l.src[l.pos+1:], '\''); end >= 0 {
+				l.pos += end + 1
+			}
+		case c == '"':
+			for l.pos++; l.pos < len(l.src) && l.src[l.pos] != '"'; l.pos++ {
+				if l.src[l.pos] == '\\' {
+					l.pos++
+				}
+			}
+		case c == '\n':
+			l.line++
+		case c == open:
+			depth++
+		case c == close:
+			depth--
+			if depth == 0 {
+				l.pos++
+				return
+			}
+		}
+		l.pos++
+	}
+	l.pos = len(l.src)
+	l.errorf("unterminated %c", open)
+}
+
+// readHeredocs consumes the bodies of pending here-documents after a newline.
+func (l *lexer) readHeredocs() {
+	for _, r := range l.pending {
+		delim := r.Target.Value
+		var body strings.Builder
+		found := false
+		for l.pos < len(l.src) {
+			end := strings.IndexByte(l.src[l.pos:], '\n')
+			lineText := l.src[l.pos:]
+			if end >= 0 {
+				lineText = l.src[l.pos : l.pos+end]
+				l.pos += end + 1
+			} else {
+				l.pos = len(l.src)
+			}
+			l.line++
+			check := lineText
+			if r.Op == "<<-" {
+				check = strings.TrimLeft(check, "\t")
+			}
+			if check == delim {
+				found = true
+				break
+			}
+			body.WriteString(lineText)
+			body.WriteByte('\n')
+		}
+		if !found {
+			l.errorf("here-document delimited by %q not terminated", delim)
+		}
+		r.Heredoc = body.String()
+	}
+	l.pending = nil
+}
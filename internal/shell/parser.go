@@ -0,0 +1,421 @@
+// file: internal/shell/parser.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+
+// Package shell parses POSIX shell scripts into a command AST.
+//
+// The parser understands quoting, parameter expansion, command substitution,
+// redirections, here-documents, pipelines, and/or lists, subshells, brace
+// groups and the if/for/while/until/case compound commands. It is tolerant of
+// malformed input: syntax errors are reported alongside the commands that
+// could still be recognized so that callers can lint partial scripts.
+package shell
+
+import (
+	"errors"
+	"strings"
+)
+
+// Parse parses a shell script into a command list.
+//
+// Parse always returns a non-nil list. When the script is malformed, the
+// returned error joins one *SyntaxError per problem and the list holds every
+// command recognized around them.
+func Parse(src string) (*List, error) {
+	list, errs := parse(src, 1)
+	if len(errs) == 0 {
+		return list, nil
+	}
+	joined := make([]error, len(errs))
+	for i, e := range errs {
+		joined[i] = e
+	}
+	return list, errors.Join(joined...)
+}
+
+// parse parses src with line numbers starting at line.
+func parse(src string, line int) (*List, []*SyntaxError) {
+	p := &parser{lex: &lexer{src: src, line: line}}
+	p.advance()
+	list := p.list()
+	for p.tok.kind != tokEOF {
+		p.lex.errorf("unexpected %q", p.tok.val)
+		p.advance()
+		more := p.list()
+		list.Items = append(list.Items, more.Items...)
+	}
+	return list, p.lex.errs
+}
+
+// parser is a recursive-descent parser over lexer tokens.
+type parser struct {
+	lex *lexer
+	tok token
+}
+
+// advance reads the next token.
+func (p *parser) advance() { p.tok = p.lex.next() }
+
+// isOp reports whether the current token is the control operator op.
+func (p *parser) isOp(op string) bool { return p.tok.kind == tokOp && p.tok.val == op }
+
+// isReserved reports whether the current token is the unquoted reserved word w.
+func (p *parser) isReserved(w string) bool {
+	return p.tok.kind == tokWord && !p.tok.word.Quoted && p.tok.val == w
+}
+
+// terminators end a list inside compound commands.
+var terminators = map[string]struct{}{
+	"then": {}, "elif": {}, "else": {}, "fi": {}, "do": {}, "done": {}, "esac": {}, "}": {},
+}
+
+// atListEnd reports whether the current token terminates a list.
+func (p *parser) atListEnd() bool {
+	switch p.tok.kind {
+	case tokEOF:
+		return true
+	case tokOp:
+		return p.tok.val == ")" || p.tok.val == ";;"
+	case tokWord:
+		if p.tok.word.Quoted {
+			return false
+		}
+		_, ok := terminators[p.tok.val]
+		return ok
+	}
+	return false
+}
+
+// skipNewlines skips newline tokens.
+func (p *parser) skipNewlines() {
+	for p.tok.kind == tokNewline {
+		p.advance()
+	}
+}
+
+// list parses and-or lists separated by `;`, `&` or newlines.
+func (p *parser) list() *List {
+	l := &List{}
+	for {
+		p.skipNewlines()
+		if p.atListEnd() {
+			return l
+		}
+		ao := p.andOr()
+		if ao == nil {
+			if p.atListEnd() {
+				return l
+			}
+			p.lex.errorf("unexpected %q", p.tok.val)
+			p.advance()
+			continue
+		}
+		l.Items = append(l.Items, ao)
+		switch {
+		case p.isOp(";"):
+			p.advance()
+		case p.isOp("&"):
+			ao.Background = true
+			p.advance()
+		case p.tok.kind == tokNewline:
+		default:
+			return l
+		}
+	}
+}
+
+// andOr parses pipelines joined by `&&` and `||`.
+func (p *parser) andOr() *AndOr {
+	pl := p.pipeline()
+	if pl == nil {
+		return nil
+	}
+	ao := &AndOr{Pipelines: []*Pipeline{pl}}
+	for p.isOp("&&") || p.isOp("||") {
+		op := p.tok.val
+		p.advance()
+		p.skipNewlines()
+		next := p.pipeline()
+		if next == nil {
+			p.lex.errorf("missing command after %q", op)
+			break
+		}
+		ao.Ops = append(ao.Ops, op)
+		ao.Pipelines = append(ao.Pipelines, next)
+	}
+	return ao
+}
+
+// pipeline parses commands joined by `|` or `|&`, with an optional leading `!`.
+func (p *parser) pipeline() *Pipeline {
+	pl := &Pipeline{}
+	if p.isReserved("!") {
+		pl.Negated = true
+		p.advance()
+	}
+	for {
+		cmd := p.command()
+		if cmd == nil {
+			if len(pl.Cmds) > 0 {
+				p.lex.errorf("missing command after \"|\"")
+			}
+			break
+		}
+		pl.Cmds = append(pl.Cmds, cmd)
+		if !p.isOp("|") && !p.isOp("|&") {
+			break
+		}
+		p.advance()
+		p.skipNewlines()
+	}
+	if len(pl.Cmds) == 0 {
+		return nil
+	}
+	return pl
+}
+
+// command parses a compound or simple command.
+func (p *parser) command() Command {
+	if p.atListEnd() {
+		return nil
+	}
+	switch {
+	case p.isOp("("):
+		p.advance()
+		body := p.list()
+		p.expectOp(")")
+		return &Subshell{Body: body, Redirs: p.redirects()}
+	case p.isReserved("{"):
+		p.advance()
+		body := p.list()
+		p.expectReserved("}")
+		return &Group{Body: body, Redirs: p.redirects()}
+	case p.isReserved("if"):
+		return p.ifClause()
+	case p.isReserved("while"), p.isReserved("until"):
+		return p.condLoop()
+	case p.isReserved("for"):
+		return p.forLoop()
+	case p.isReserved("case"):
+		return p.caseClause()
+	case p.isReserved("function"):
+		p.advance()
+		name := p.tok.val
+		p.advance()
+		if p.isOp("(") {
+			p.advance()
+			p.expectOp(")")
+		}
+		return p.funcBody(name)
+	}
+	return p.simple()
+}
+
+// simple parses a simple command, detecting `name()` function definitions.
+func (p *parser) simple() Command {
+	c := &SimpleCommand{Line: p.tok.line}
+	for {
+		switch p.tok.kind {
+		case tokRedir:
+			c.Redirs = append(c.Redirs, p.redirect())
+			continue
+		case tokWord:
+			w := p.tok.word
+			if len(c.Args) == 0 && isAssignment(w) {
+				c.Assigns = append(c.Assigns, w)
+				p.advance()
+				continue
+			}
+			c.Args = append(c.Args, w)
+			p.advance()
+			if len(c.Args) == 1 && len(c.Assigns) == 0 && p.isOp("(") {
+				p.advance()
+				if p.isOp(")") {
+					p.advance()
+					return p.funcBody(w.Value)
+				}
+				p.lex.errorf("unexpected \"(\" after %q", w.Value)
+			}
+			continue
+		}
+		break
+	}
+	if len(c.Args) == 0 && len(c.Assigns) == 0 && len(c.Redirs) == 0 {
+		return nil
+	}
+	return c
+}
+
+// funcBody parses the compound command forming a function body.
+func (p *parser) funcBody(name string) Command {
+	p.skipNewlines()
+	body := p.command()
+	if body == nil {
+		p.lex.errorf("missing body for function %q", name)
+	}
+	return &FuncDecl{Name: name, Body: body}
+}
+
+// redirect parses a single redirection, registering here-documents for body capture.
+func (p *parser) redirect() *Redirect {
+	r := &Redirect{N: p.tok.n, Op: p.tok.val}
+	p.advance()
+	if p.tok.kind != tokWord {
+		p.lex.errorf("missing target for %q", r.Op)
+		r.Target = &Word{}
+		return r
+	}
+	r.Target = p.tok.word
+	if r.Op == "<<" || r.Op == "<<-" {
+		p.lex.pending = append(p.lex.pending, r)
+	}
+	p.advance()
+	return r
+}
+
+// redirects parses redirections trailing a compound command.
+func (p *parser) redirects() []*Redirect {
+	var out []*Redirect
+	for p.tok.kind == tokRedir {
+		out = append(out, p.redirect())
+	}
+	return out
+}
+
+// expectOp consumes the control operator op or records an error.
+func (p *parser) expectOp(op string) {
+	if p.isOp(op) {
+		p.advance()
+		return
+	}
+	p.lex.errorf("expected %q", op)
+}
+
+// expectReserved consumes the reserved word w or records an error.
+func (p *parser) expectReserved(w string) {
+	if p.isReserved(w) {
+		p.advance()
+		return
+	}
+	p.lex.errorf("expected %q", w)
+}
+
+// ifClause parses an if/elif/else/fi command.
+func (p *parser) ifClause() Command {
+	c := &IfClause{}
+	for p.isReserved("if") || p.isReserved("elif") {
+		p.advance()
+		cond := p.list()
+		p.expectReserved("then")
+		body := p.list()
+		c.Blocks = append(c.Blocks, CondBlock{Cond: cond, Body: body})
+	}
+	if p.isReserved("else") {
+		p.advance()
+		c.Else = p.list()
+	}
+	p.expectReserved("fi")
+	c.Redirs = p.redirects()
+	return c
+}
+
+// condLoop parses a while or until loop.
+func (p *parser) condLoop() Command {
+	c := &Loop{Kind: p.tok.val}
+	p.advance()
+	c.Cond = p.list()
+	c.Body = p.doGroup()
+	c.Redirs = p.redirects()
+	return c
+}
+
+// forLoop parses a `for name [in words]; do ...; done` loop.
+func (p *parser) forLoop() Command {
+	c := &Loop{Kind: "for"}
+	p.advance()
+	if p.tok.kind == tokWord {
+		c.Var = p.tok.val
+		p.advance()
+	}
+	p.skipNewlines()
+	if p.isReserved("in") {
+		p.advance()
+		for p.tok.kind == tokWord {
+			c.Items = append(c.Items, p.tok.word)
+			p.advance()
+		}
+	}
+	if p.isOp(";") {
+		p.advance()
+	}
+	c.Body = p.doGroup()
+	c.Redirs = p.redirects()
+	return c
+}
+
+// doGroup parses a `do ... done` body.
+func (p *parser) doGroup() *List {
+	p.skipNewlines()
+	p.expectReserved("do")
+	body := p.list()
+	p.expectReserved("done")
+	return body
+}
+
+// caseClause parses a `case word in pattern) list ;; ... esac` command.
+func (p *parser) caseClause() Command {
+	c := &CaseClause{}
+	p.advance()
+	if p.tok.kind == tokWord {
+		c.Word = p.tok.word
+		p.advance()
+	}
+	p.skipNewlines()
+	p.expectReserved("in")
+	for {
+		p.skipNewlines()
+		if p.isReserved("esac") || p.tok.kind == tokEOF {
+			break
+		}
+		if p.isOp("(") {
+			p.advance()
+		}
+		var item CaseItem
+		for p.tok.kind == tokWord {
+			item.Patterns = append(item.Patterns, p.tok.word)
+			p.advance()
+			if !p.isOp("|") {
+				break
+			}
+			p.advance()
+		}
+		if !p.isOp(")") {
+			p.lex.errorf("expected \")\" in case pattern")
+			break
+		}
+		p.advance()
+		item.Body = p.list()
+		c.Items = append(c.Items, item)
+		if p.isOp(";;") {
+			p.advance()
+		}
+	}
+	p.expectReserved("esac")
+	c.Redirs = p.redirects()
+	return c
+}
+
+// isAssignment reports whether w is a NAME=value assignment word.
+func isAssignment(w *Word) bool {
+	eq := strings.IndexByte(w.Raw, '=')
+	if eq <= 0 {
+		return false
+	}
+	for i := 0; i < eq; i++ {
+		c := w.Raw[i]
+		if c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (i > 0 && c >= '0' && c <= '9') {
+			continue
+		}
+		return false
+	}
+	return true
+}
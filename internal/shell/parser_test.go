@@ -0,0 +1,166 @@
+// file: internal/shell/parser_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package shell
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// argvs returns the argument vectors of every simple command in src.
+func argvs(t *testing.T, src string) [][]string {
+	t.Helper()
+	list, err := Parse(src)
+	if err != nil {
+		t.Fatalf("parse %q: %v", src, err)
+	}
+	var out [][]string
+	for _, c := range Commands(list) {
+		out = append(out, c.Argv())
+	}
+	return out
+}
+
+// TestParseCommands covers command extraction across shell constructs.
+func TestParseCommands(t *testing.T) {
+	cases := []struct {
+		src  string
+		want [][]string
+	}{
+		{"apt-get update && apt-get install -y curl", [][]string{{"apt-get", "update"}, {"apt-get", "install", "-y", "curl"}}},
+		{"DEBIAN_FRONTEND=noninteractive apt-get install -y curl", [][]string{{"apt-get", "install", "-y", "curl"}}},
+		{"echo a; echo b || echo c | grep c &", [][]string{{"echo", "a"}, {"echo", "b"}, {"echo", "c"}, {"grep", "c"}}},
+		{"(cd /tmp && make) >/dev/null 2>&1", [][]string{{"cd", "/tmp"}, {"make"}}},
+		{"{ echo a; echo b; }", [][]string{{"echo", "a"}, {"echo", "b"}}},
+		{"if [ -f x ]; then apt-get install -y a; elif true; then :; else exit 1; fi", [][]string{{"[", "-f", "x", "]"}, {"apt-get", "install", "-y", "a"}, {"true"}, {":"}, {"exit", "1"}}},
+		{"for p in a b; do pip install $p; done", [][]string{{"pip", "install", "$p"}}},
+		{"while read l; do echo $l; done < file", [][]string{{"read", "l"}, {"echo", "$l"}}},
+		{"case $x in a|b) echo ab ;; *) echo other ;; esac", [][]string{{"echo", "ab"}, {"echo", "other"}}},
+		{"echo $(apt-get install -y vim) `ls`", [][]string{{"echo", "$(apt-get install -y vim)", "`ls`"}, {"apt-get", "install", "-y", "vim"}, {"ls"}}},
+		{"echo 'a && b' \"c; $HOME\" d\\ e", [][]string{{"echo", "a && b", "c; $HOME", "d e"}}},
+		{"f() { echo hi; }; f", [][]string{{"echo", "hi"}, {"f"}}},
+		{"apt-get install \\\n  curl \\\n  vim # trailing comment", [][]string{{"apt-get", "install", "curl", "vim"}}},
+		{"! grep -q x file", [][]string{{"grep", "-q", "x", "file"}}},
+		{"echo $((1 + (2 * 3))) ${VAR:-x}", [][]string{{"echo", "$((1 + (2 * 3)))", "${VAR:-x}"}}},
+		{"X=1 Y=2", nil},
+		{"diff <(sort a) >(cat)", [][]string{{"diff", "<(sort a)", ">(cat)"}, {"sort", "a"}, {"cat"}}},
+		{"bash < <(curl -fsSL https://x)", [][]string{{"bash"}, {"curl", "-fsSL", "https://x"}}},
+	}
+	for _, tc := range cases {
+		if got := argvs(t, tc.src); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%q: got %q want %q", tc.src, got, tc.want)
+		}
+	}
+}
+
+// TestParseRedirects verifies redirections are separated from arguments.
+func TestParseRedirects(t *testing.T) {
+	list, err := Parse("curl -o out.txt http://x 2>&1 >> log &> all")
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	cmds := Commands(list)
+	if len(cmds) != 1 {
+		t.Fatalf("expected 1 command, got %d", len(cmds))
+	}
+	c := cmds[0]
+	if !reflect.DeepEqual(c.Argv(), []string{"curl", "-o", "out.txt", "http://x"}) {
+		t.Fatalf("unexpected argv %q", c.Argv())
+	}
+	var ops []string
+	for _, r := range c.Redirs {
+		ops = append(ops, r.N+r.Op+r.Target.Value)
+	}
+	if !reflect.DeepEqual(ops, []string{"2>&1", ">>log", "&>all"}) {
+		t.Fatalf("unexpected redirects %q", ops)
+	}
+}
+
+// TestParseHeredoc verifies here-document bodies are captured and skipped.
+func TestParseHeredoc(t *testing.T) {
+	src := "cat <<EOF > /etc/conf\nline $one\n  EOF-not\nEOF\necho done\ncat <<-END\n\tindented\n\tEND\n"
+	list, err := Parse(src)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	cmds := Commands(list)
+	if len(cmds) != 3 {
+		t.Fatalf("expected 3 commands, got %d", len(cmds))
+	}
+	if got := cmds[0].Redirs[0].Heredoc; got != "line $one\n  EOF-not\n" {
+		t.Fatalf("unexpected heredoc body %q", got)
+	}
+	if cmds[1].Name() != "echo" || cmds[1].Line != 5 {
+		t.Fatalf("unexpected command after heredoc: %q line %d", cmds[1].Argv(), cmds[1].Line)
+	}
+	if got := cmds[2].Redirs[0].Heredoc; got != "\tindented\n" {
+		t.Fatalf("unexpected stripped heredoc %q", got)
+	}
+}
+
+// TestParseAssignments verifies environment prefix assignments.
+func TestParseAssignments(t *testing.T) {
+	list, _ := Parse("A=1 B=\"x y\" make install")
+	c := Commands(list)[0]
+	if len(c.Assigns) != 2 || c.Assigns[1].Value != "B=x y" || c.Name() != "make" {
+		t.Fatalf("unexpected command: %+v", c)
+	}
+	if isAssignment(&Word{Raw: "1A=b"}) || isAssignment(&Word{Raw: "=x"}) || isAssignment(&Word{Raw: "a-b=c"}) {
+		t.Fatalf("invalid assignment accepted")
+	}
+}
+
+// TestParseLines verifies command line numbers.
+func TestParseLines(t *testing.T) {
+	list, _ := Parse("echo a\n\nif true; then\n  echo b\nfi\n")
+	cmds := Commands(list)
+	var lines []int
+	for _, c := range cmds {
+		lines = append(lines, c.Line)
+	}
+	if !reflect.DeepEqual(lines, []int{1, 3, 4}) {
+		t.Fatalf("unexpected lines %v", lines)
+	}
+}
+
+// TestParseErrors verifies malformed scripts report errors but keep recognized commands.
+func TestParseErrors(t *testing.T) {
+	cases := map[string]string{
+		"echo 'unterminated && apt-get install x": "unterminated single quote",
+		"echo \"open":        "unterminated double quote",
+		"echo $(ls":          "unterminated (",
+		"echo `ls":           "unterminated backtick",
+		"cat <<EOF\nbody\n":  "not terminated",
+		"if true; then echo": `expected "fi"`,
+		"echo a && ":         "missing command",
+		"echo a ) echo b":    `unexpected ")"`,
+	}
+	for src, want := range cases {
+		list, err := Parse(src)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%q: expected error containing %q, got %v", src, want, err)
+		}
+		if list == nil || len(Commands(list)) == 0 {
+			t.Errorf("%q: expected partial commands", src)
+		}
+	}
+}
+
+// TestInspectSkip verifies that returning false prunes traversal.
+func TestInspectSkip(t *testing.T) {
+	list, _ := Parse("(echo a) && echo b")
+	var names []string
+	Inspect(list, func(n Node) bool {
+		if _, ok := n.(*Subshell); ok {
+			return false
+		}
+		if c, ok := n.(*SimpleCommand); ok {
+			names = append(names, c.Name())
+		}
+		return true
+	})
+	if !reflect.DeepEqual(names, []string{"echo"}) || Commands(nil) != nil {
+		t.Fatalf("unexpected traversal %v", names)
+	}
+}
@@ -0,0 +1,119 @@
+// file: internal/shell/walk.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package shell
+
+// Inspect traverses the AST in source order, calling fn for each node.
+//
+// If fn returns false, the children of that node are skipped. Command
+// substitutions within words are visited after the command that contains them.
+func Inspect(n Node, fn func(Node) bool) {
+	if n == nil || isNilNode(n) || !fn(n) {
+		return
+	}
+	switch n := n.(type) {
+	case *List:
+		for _, ao := range n.Items {
+			Inspect(ao, fn)
+		}
+	case *AndOr:
+		for _, pl := range n.Pipelines {
+			Inspect(pl, fn)
+		}
+	case *Pipeline:
+		for _, c := range n.Cmds {
+			Inspect(c, fn)
+		}
+	case *SimpleCommand:
+		inspectWords(n.Assigns, fn)
+		inspectWords(n.Args, fn)
+		inspectRedirects(n.Redirs, fn)
+	case *Subshell:
+		Inspect(n.Body, fn)
+		inspectRedirects(n.Redirs, fn)
+	case *Group:
+		Inspect(n.Body, fn)
+		inspectRedirects(n.Redirs, fn)
+	case *IfClause:
+		for _, b := range n.Blocks {
+			Inspect(b.Cond, fn)
+			Inspect(b.Body, fn)
+		}
+		Inspect(n.Else, fn)
+		inspectRedirects(n.Redirs, fn)
+	case *Loop:
+		inspectWords(n.Items, fn)
+		Inspect(n.Cond, fn)
+		Inspect(n.Body, fn)
+		inspectRedirects(n.Redirs, fn)
+	case *CaseClause:
+		if n.Word != nil {
+			inspectWords([]*Word{n.Word}, fn)
+		}
+		for _, it := range n.Items {
+			Inspect(it.Body, fn)
+		}
+		inspectRedirects(n.Redirs, fn)
+	case *FuncDecl:
+		Inspect(n.Body, fn)
+	}
+}
+
+// Commands returns every simple command in the AST, in source order.
+//
+// Commands nested in compound commands, function bodies and command
+// substitutions are included. Assignment-only commands are omitted.
+func Commands(n Node) []*SimpleCommand {
+	var out []*SimpleCommand
+	Inspect(n, func(n Node) bool {
+		if c, ok := n.(*SimpleCommand); ok && len(c.Args) > 0 {
+			out = append(out, c)
+		}
+		return true
+	})
+	return out
+}
+
+// inspectWords visits command substitutions nested in words.
+func inspectWords(words []*Word, fn func(Node) bool) {
+	for _, w := range words {
+		for _, s := range w.Subs {
+			Inspect(s, fn)
+		}
+	}
+}
+
+// inspectRedirects visits command substitutions nested in redirection targets.
+func inspectRedirects(redirs []*Redirect, fn func(Node) bool) {
+	for _, r := range redirs {
+		if r.Target != nil {
+			inspectWords([]*Word{r.Target}, fn)
+		}
+	}
+}
+
+// isNilNode reports whether n holds a typed nil pointer.
+func isNilNode(n Node) bool {
+	switch n := n.(type) {
+	case *List:
+		return n == nil
+	case *AndOr:
+		return n == nil
+	case *Pipeline:
+		return n == nil
+	case *SimpleCommand:
+		return n == nil
+	case *Subshell:
+		return n == nil
+	case *Group:
+		return n == nil
+	case *IfClause:
+		return n == nil
+	case *Loop:
+		return n == nil
+	case *CaseClause:
+		return n == nil
+	case *FuncDecl:
+		return n == nil
+	}
+	return false
+}
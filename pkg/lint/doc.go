@@ -0,0 +1,45 @@
+// file: pkg/lint/doc.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+
+// Package lint embeds docker-lint in Go programs.
+//
+// A Linter is created with New and configured with functional options:
+//
+//	l, err := lint.New(
+//		lint.WithConfigFile(".docker-lint.yaml"),
+//		lint.WithoutRules("DL3007"),
+//		lint.WithCustomRules(myRule{}),
+//	)
+//	if err != nil {
+//		return err
+//	}
+//	findings, err := l.LintFile(ctx, "Dockerfile")
+//
+// Custom rules implement Rule and receive the parsed Document, which exposes
+// the build stages, the raw BuildKit AST and shared views such as the
+// commands of each RUN instruction. Rules may also implement Describer to
+// supply Metadata, which WithCategories and WithoutCategories select on.
+//
+// # Compatibility
+//
+// Package lint follows semantic versioning together with the docker-lint
+// module. Within a major version:
+//
+//   - Exported identifiers of this package are not removed or renamed, and
+//     function signatures do not change.
+//   - New options, methods and struct fields may be added. Callers should use
+//     keyed struct literals and must not implement interfaces defined here
+//     other than Rule and Describer.
+//   - The Rule interface does not change; optional capabilities are added as
+//     separate interfaces that rules may implement.
+//   - Finding, Metadata, Document, Stage and Config are defined by this
+//     package and converted from the linter's internal model, so changes to
+//     internal packages do not alter them.
+//   - The JSON encoding of Finding is stable; fields may be added.
+//   - Rule IDs are stable, but the default rule set and the exact wording of
+//     messages may change in minor releases.
+//
+// Document exposes the BuildKit parser AST, whose API is governed by the
+// github.com/moby/buildkit module rather than by this policy. Packages under
+// internal/ carry no compatibility guarantee.
+package lint
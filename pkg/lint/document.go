@@ -0,0 +1,106 @@
+// file: pkg/lint/document.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package lint
+
+import (
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// Document is a parsed Dockerfile with its build stages.
+//
+// Derived views such as the commands of RUN instructions are computed once
+// per lint run and shared by all rules.
+type Document struct {
+	// Path is the file the Dockerfile was read from, or the name given to
+	// LintBytes or Parse.
+	Path string
+	// Stages lists the build stages in file order.
+	Stages []*Stage
+	// AST is the BuildKit parser AST of the whole file.
+	AST *parser.Node
+
+	doc *ir.Document
+}
+
+// Stage is a single build stage of a Document.
+type Stage struct {
+	// Index is the position of the stage in the Dockerfile, starting at 0.
+	Index int
+	// Name is the name given with FROM ... AS, or empty.
+	Name string
+	// From is the image or stage the stage is built from, as written.
+	From string
+	// Node is the FROM instruction.
+	Node *parser.Node
+	// Instructions holds the instructions following FROM up to the next stage.
+	Instructions []*parser.Node
+	// Parent is the index of the stage named by FROM, or -1 for an external image.
+	Parent int
+	// Deps lists the indexes of the stages this stage consumes via FROM,
+	// COPY --from or RUN --mount=from=.
+	Deps []int
+	// Reachable reports whether building the target stage requires this stage.
+	Reachable bool
+}
+
+// Command is a simple command executed by a RUN instruction.
+//
+// Argv excludes environment prefix assignments and redirections. Line is the
+// Dockerfile line on which the command starts.
+type Command struct {
+	Argv []string
+	Line int
+}
+
+// newDocument wraps an internal document.
+func newDocument(d *ir.Document) *Document {
+	doc := &Document{Path: d.Filepath, AST: d.AST, doc: d}
+	for _, st := range d.Stages {
+		doc.Stages = append(doc.Stages, &Stage{
+			Index:        st.Index,
+			Name:         st.Name,
+			From:         st.From,
+			Node:         st.Node,
+			Instructions: st.Instructions,
+			Parent:       st.Parent,
+			Deps:         st.Deps,
+			Reachable:    st.Reachable,
+		})
+	}
+	return doc
+}
+
+// TargetStage returns the stage being built, or nil when there are no stages.
+func (d *Document) TargetStage() *Stage { return d.stage(d.doc.TargetStage()) }
+
+// StageOf returns the stage containing instruction n, or nil when n precedes
+// the first FROM or is not part of the document.
+func (d *Document) StageOf(n *parser.Node) *Stage { return d.stage(d.doc.StageOf(n)) }
+
+// stage returns the Stage for an internal stage.
+func (d *Document) stage(st *ir.Stage) *Stage {
+	if st == nil {
+		return nil
+	}
+	return d.Stages[st.Index]
+}
+
+// Instructions returns the instructions with the given keyword, matched
+// case-insensitively, in file order.
+func (d *Document) Instructions(keyword string) []*parser.Node { return d.doc.Instructions(keyword) }
+
+// RunCommands returns the simple commands that RUN or HEALTHCHECK
+// instruction n executes.
+func (d *Document) RunCommands(n *parser.Node) []Command {
+	var out []Command
+	for _, c := range d.doc.RunCommands(n) {
+		out = append(out, Command{Argv: c.Argv, Line: c.Line})
+	}
+	return out
+}
+
+// Expand substitutes the build variables in scope at instruction n into
+// word. ok is false when word references a variable without a known value.
+func (d *Document) Expand(n *parser.Node, word string) (string, bool) { return d.doc.Expand(n, word) }
@@ -0,0 +1,141 @@
+// file: pkg/lint/lint.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package lint
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+	"github.com/asymmetric-effort/docker-lint/internal/rules"
+)
+
+// Linter checks Dockerfiles against a fixed set of rules.
+//
+// A Linter is safe for concurrent use by multiple goroutines.
+type Linter struct {
+	reg        *engine.Registry
+	sel        engine.Selection
+	target     string
+	contextDir string
+}
+
+// New creates a Linter running the built-in rules that are not opt-in and the
+// configuration's custom rules, policies and plugin rules, adjusted by opts
+// and the configuration's rule selection.
+func New(opts ...Option) (*Linter, error) {
+	o := options{}
+	for _, opt := range opts {
+		if err := opt(&o); err != nil {
+			return nil, err
+		}
+	}
+	var engOpts []engine.Option
+	if o.concurrency > 0 {
+		engOpts = append(engOpts, engine.WithRuleConcurrency(o.concurrency))
+	}
+	if o.ruleTimeout > 0 {
+		engOpts = append(engOpts, engine.WithRuleTimeout(o.ruleTimeout))
+	}
+	reg := engine.NewRegistry(engOpts...)
+	cfg := o.cfg.internal()
+	custom := make([]engine.Rule, 0, len(o.custom))
+	for _, r := range o.custom {
+		custom = append(custom, adaptRule(r))
+	}
+	sel := rules.Selection(cfg).Merge(o.sel)
+	kept, err := rules.Load(context.Background(), cfg, sel, custom...)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range kept {
+		reg.Register(r)
+	}
+	return &Linter{reg: reg, sel: sel, target: o.target, contextDir: o.contextDir}, nil
+}
+
+// Rules returns the IDs of the rules the Linter runs, in evaluation order.
+func (l *Linter) Rules() []string { return l.reg.IDs() }
+
+// LintFile reads and lints the Dockerfile at path. Its directory is the
+// build context, whose .dockerignore file the context rules inspect, unless
+// WithBuildContext names another one.
+func (l *Linter) LintFile(ctx context.Context, path string) ([]Finding, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := parse(path, bytes.NewReader(src), l.target)
+	if err != nil {
+		return nil, err
+	}
+	if doc.Context, err = ir.LoadContext(path, l.contextDir); err != nil {
+		return nil, err
+	}
+	return l.run(ctx, doc)
+}
+
+// LintBytes lints Dockerfile source; name is recorded as the document path.
+func (l *Linter) LintBytes(ctx context.Context, name string, src []byte) ([]Finding, error) {
+	doc, err := parse(name, bytes.NewReader(src), l.target)
+	if err != nil {
+		return nil, err
+	}
+	return l.run(ctx, doc)
+}
+
+// LintDocument runs the rules against an already parsed document. It fails
+// when doc is nil.
+func (l *Linter) LintDocument(ctx context.Context, doc *Document) ([]Finding, error) {
+	if doc == nil {
+		return nil, errors.New("lint: nil document")
+	}
+	return l.run(ctx, doc.doc)
+}
+
+// run runs the rules against an internal document. Findings for disabled
+// rules, such as failures of a disabled plugin, are dropped.
+func (l *Linter) run(ctx context.Context, doc *ir.Document) ([]Finding, error) {
+	fnds, err := l.reg.Run(ctx, doc)
+	var kept []engine.Finding
+	for _, f := range fnds {
+		if !l.sel.Disabled(f.RuleID) {
+			kept = append(kept, f)
+		}
+	}
+	return findingsOf(kept), err
+}
+
+// Parse parses Dockerfile source into a Document whose target is the final stage.
+func Parse(name string, src []byte) (*Document, error) {
+	doc, err := parse(name, bytes.NewReader(src), "")
+	if err != nil {
+		return nil, err
+	}
+	return newDocument(doc), nil
+}
+
+// parse parses Dockerfile source read from r, selecting target when non-empty.
+func parse(name string, r io.Reader, target string) (*ir.Document, error) {
+	res, err := parser.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := ir.BuildDocument(name, res.AST)
+	if err != nil {
+		return nil, err
+	}
+	if target != "" {
+		if err := doc.SetTarget(target); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return doc, nil
+}
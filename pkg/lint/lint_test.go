@@ -0,0 +1,327 @@
+// file: pkg/lint/lint_test.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package lint
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// userRule flags documents whose final stage sets no USER.
+type userRule struct{}
+
+func (userRule) ID() string { return "ORG001" }
+
+func (userRule) Check(_ context.Context, d *Document) ([]Finding, error) {
+	st := d.Stages[len(d.Stages)-1]
+	for _, n := range st.Instructions {
+		if strings.EqualFold(n.Value, "user") {
+			return nil, nil
+		}
+	}
+	return []Finding{{RuleID: "ORG001", Message: "final stage runs as root", Line: st.Node.StartLine}}, nil
+}
+
+// runRule flags RUN instructions that call curl, describing itself as a security rule.
+type runRule struct{}
+
+func (runRule) ID() string { return "ORG002" }
+
+func (runRule) Metadata() Metadata {
+	return Metadata{Title: "No curl", Category: CategorySecurity, Severity: "error"}
+}
+
+func (runRule) Check(_ context.Context, d *Document) ([]Finding, error) {
+	var out []Finding
+	for _, n := range d.Instructions("run") {
+		for _, c := range d.RunCommands(n) {
+			if c.Argv[0] == "curl" && d.StageOf(n) == d.TargetStage() {
+				out = append(out, Finding{RuleID: "ORG002", Message: "curl", Line: c.Line})
+			}
+		}
+	}
+	return out, nil
+}
+
+// ruleIDs returns the rule IDs of findings.
+func ruleIDs(fnds []Finding) []string {
+	var ids []string
+	for _, f := range fnds {
+		ids = append(ids, f.RuleID)
+	}
+	return ids
+}
+
+// TestIntegrationLintBytes verifies default and custom rules run against source bytes.
+func TestIntegrationLintBytes(t *testing.T) {
+	l, err := New(WithCustomRules(userRule{}))
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	fnds, err := l.LintBytes(context.Background(), "Dockerfile", []byte("FROM alpine:latest\n"))
+	if err != nil {
+		t.Fatalf("lint: %v", err)
+	}
+	ids := ruleIDs(fnds)
+	if !slices.Contains(ids, "DL3007") || !slices.Contains(ids, "ORG001") {
+		t.Fatalf("expected DL3007 and ORG001, got %v", ids)
+	}
+}
+
+// TestIntegrationLintFileConfig verifies that configuration and rule filters disable rules.
+func TestIntegrationLintFileConfig(t *testing.T) {
+	dir := t.TempDir()
+	cfg := filepath.Join(dir, "cfg.yaml")
+	df := filepath.Join(dir, "Dockerfile")
+	if err := os.WriteFile(cfg, []byte("ignored:\n  - DL3007\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(df, []byte("FROM alpine:latest\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	l, err := New(WithConfigFile(cfg), WithCustomRules(userRule{}), WithoutRules("ORG001"))
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	fnds, err := l.LintFile(context.Background(), df)
+	if err != nil {
+		t.Fatalf("lint: %v", err)
+	}
+	if ids := ruleIDs(fnds); slices.Contains(ids, "DL3007") || slices.Contains(ids, "ORG001") {
+		t.Fatalf("expected DL3007 and ORG001 disabled, got %v", ids)
+	}
+}
+
+// TestIntegrationWithBuildContext verifies that LintFile checks COPY sources against the given context.
+func TestIntegrationWithBuildContext(t *testing.T) {
+	dir := t.TempDir()
+	df := filepath.Join(dir, "Dockerfile")
+	if err := os.WriteFile(df, []byte("FROM alpine:3.19\nCOPY missing.conf /etc/\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		opts []Option
+		want bool
+	}{
+		{[]Option{WithRules("DL3088")}, false},
+		{[]Option{WithRules("DL3088"), WithBuildContext(dir)}, true},
+	} {
+		l, err := New(c.opts...)
+		if err != nil {
+			t.Fatalf("new: %v", err)
+		}
+		fnds, err := l.LintFile(context.Background(), df)
+		if err != nil {
+			t.Fatalf("lint: %v", err)
+		}
+		if got := slices.Contains(ruleIDs(fnds), "DL3088"); got != c.want {
+			t.Fatalf("expected DL3088 reported %v, got %v", c.want, ruleIDs(fnds))
+		}
+	}
+}
+
+// TestWithRules verifies rule selection and its validation.
+func TestWithRules(t *testing.T) {
+	l, err := New(WithRules("DL3007"))
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	if got := l.Rules(); !slices.Equal(got, []string{"DL3007"}) {
+		t.Fatalf("expected only DL3007, got %v", got)
+	}
+	if _, err := New(WithRules("NOPE")); err == nil || !strings.Contains(err.Error(), `no rule matches "NOPE"`) {
+		t.Fatalf("expected unknown rule error, got %v", err)
+	}
+	if _, err := New(WithCustomRules(userRule{}, userRule{})); err == nil || !strings.Contains(err.Error(), "duplicate rule") {
+		t.Fatalf("expected duplicate rule error, got %v", err)
+	}
+}
+
+// failingRule reports a failure under a rule ID of its own, as plugin rules do.
+type failingRule struct{}
+
+func (failingRule) ID() string { return "ORG002" }
+
+func (failingRule) Check(context.Context, *Document) ([]Finding, error) {
+	return []Finding{{RuleID: "plugin/acme", Message: "plugin acme failed"}}, nil
+}
+
+// TestDisabledFindings verifies that findings of disabled rule IDs are dropped.
+func TestDisabledFindings(t *testing.T) {
+	for _, c := range []struct {
+		opts []Option
+		want bool
+	}{
+		{[]Option{WithCustomRules(failingRule{}), WithRules("ORG002")}, true},
+		{[]Option{WithCustomRules(failingRule{}), WithRules("ORG002"), WithoutRules("plugin/*")}, false},
+	} {
+		l, err := New(c.opts...)
+		if err != nil {
+			t.Fatalf("new: %v", err)
+		}
+		fnds, err := l.LintBytes(context.Background(), "Dockerfile", []byte("FROM alpine:3.20\n"))
+		if err != nil {
+			t.Fatalf("lint: %v", err)
+		}
+		if got := slices.Contains(ruleIDs(fnds), "plugin/acme"); got != c.want {
+			t.Fatalf("expected plugin/acme reported %v, got %v", c.want, ruleIDs(fnds))
+		}
+	}
+}
+
+// TestOptInRules verifies that opt-in rules only run when enabled and that patterns match rule IDs.
+func TestOptInRules(t *testing.T) {
+	l, err := New()
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	if got := l.Rules(); slices.Contains(got, "DL3057") || !slices.Contains(got, "DL3006") {
+		t.Fatalf("unexpected default rules %v", got)
+	}
+	l, err = New(WithEnabledRules("dl3057"), WithoutRules("DL300*"))
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	if got := l.Rules(); slices.Contains(got, "DL3006") || !slices.Contains(got, "DL3057") || !slices.Contains(got, "DL4006") {
+		t.Fatalf("unexpected rules %v", got)
+	}
+	l, err = New(WithRules("DL305?"), WithConfig(&Config{Disable: []string{"DL3050"}}))
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	if got := l.Rules(); !slices.Equal(got, []string{"DL3051", "DL3052", "DL3053", "DL3054", "DL3055", "DL3056", "DL3057", "DL3058", "DL3059"}) {
+		t.Fatalf("unexpected rules %v", got)
+	}
+	if _, err := New(WithRules("DL[")); err == nil || !strings.Contains(err.Error(), "invalid rule pattern") {
+		t.Fatalf("expected pattern error, got %v", err)
+	}
+}
+
+// TestWithCategories verifies category selection by rule metadata.
+func TestWithCategories(t *testing.T) {
+	l, err := New(WithCategories(CategoryMultiStage), WithCustomRules(userRule{}))
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	if got := l.Rules(); !slices.Equal(got, []string{"DL3022", "DL3023", "DL3024", "DL3062"}) {
+		t.Fatalf("expected the multi-stage rules, got %v", got)
+	}
+	l, err = New(WithoutCategories(CategoryPackageManagement, CategoryMultiStage))
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	if got := l.Rules(); slices.Contains(got, "DL3008") || slices.Contains(got, "DL3062") || !slices.Contains(got, "DL3007") {
+		t.Fatalf("unexpected rules %v", got)
+	}
+	if m := Describe(userRule{}); m.Title != (userRule{}).ID() || m.Category != "" {
+		t.Fatalf("unexpected metadata %+v", m)
+	}
+}
+
+// TestIntegrationDescribedCustomRule verifies that custom rule metadata and document views cross the API boundary.
+func TestIntegrationDescribedCustomRule(t *testing.T) {
+	if m := Describe(runRule{}); m.Title != "No curl" || m.Category != CategorySecurity || m.Severity != "error" {
+		t.Fatalf("unexpected metadata %+v", m)
+	}
+	l, err := New(WithCategories(CategorySecurity), WithCustomRules(runRule{}))
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	if !slices.Contains(l.Rules(), "ORG002") {
+		t.Fatalf("expected ORG002 selected, got %v", l.Rules())
+	}
+	src := []byte("FROM alpine:3.20 AS build\nRUN curl -o /x https://example.com\nFROM alpine:3.20\nRUN apk add curl && curl https://example.com\n")
+	fnds, err := l.LintBytes(context.Background(), "Dockerfile", src)
+	if err != nil {
+		t.Fatalf("lint: %v", err)
+	}
+	var lines []int
+	for _, f := range fnds {
+		if f.RuleID == "ORG002" {
+			lines = append(lines, f.Line)
+		}
+	}
+	if !slices.Equal(lines, []int{4}) {
+		t.Fatalf("expected ORG002 on line 4, got %v", fnds)
+	}
+}
+
+// TestIntegrationLoadConfigKeepsDeclarations verifies that rules declared in a configuration file survive LoadConfig.
+func TestIntegrationLoadConfigKeepsDeclarations(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "cfg.yaml")
+	src := "custom-rules:\n  - id: ORG100\n    message: no sudo\n    instruction: RUN\n    match:\n      command: sudo\n"
+	if err := os.WriteFile(p, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := LoadConfig(p)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	cfg.Disable = append(cfg.Disable, "DL3004")
+	l, err := New(WithConfig(cfg))
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	if got := l.Rules(); !slices.Contains(got, "ORG100") || slices.Contains(got, "DL3004") {
+		t.Fatalf("unexpected rules %v", got)
+	}
+}
+
+// TestIntegrationWithTarget verifies that the target stage is selected and validated.
+func TestIntegrationWithTarget(t *testing.T) {
+	src := []byte("FROM alpine:3.20 AS build\nFROM alpine:3.20\n")
+	l, err := New(WithTarget("build"), WithRules("DL3007"))
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	if _, err := l.LintBytes(context.Background(), "Dockerfile", src); err != nil {
+		t.Fatalf("lint: %v", err)
+	}
+	l, err = New(WithTarget("nope"))
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	if _, err := l.LintBytes(context.Background(), "Dockerfile", src); err == nil {
+		t.Fatalf("expected target error")
+	}
+}
+
+// TestNewConfigFileError verifies that an unreadable config file fails New.
+func TestNewConfigFileError(t *testing.T) {
+	if _, err := New(WithConfigFile(filepath.Join(t.TempDir(), "missing.yaml"))); err == nil {
+		t.Fatalf("expected config error")
+	}
+}
+
+// TestNewInvalidPorts verifies that a malformed port entry fails New.
+func TestNewInvalidPorts(t *testing.T) {
+	if _, err := New(WithConfig(&Config{AllowedPorts: []string{"22a"}})); err == nil || !strings.Contains(err.Error(), "allowed-ports") {
+		t.Fatalf("expected port error, got %v", err)
+	}
+}
+
+// TestParse verifies that Parse exposes the document stages.
+func TestParse(t *testing.T) {
+	doc, err := Parse("Dockerfile", []byte("FROM alpine:3.20 AS base\nRUN echo hi\n"))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(doc.Stages) != 1 || doc.Stages[0].Name != "base" {
+		t.Fatalf("unexpected stages: %+v", doc.Stages)
+	}
+}
+
+// TestLintDocumentNil verifies that a nil document is an error rather than a panic.
+func TestLintDocumentNil(t *testing.T) {
+	l, err := New()
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	if _, err := l.LintDocument(context.Background(), nil); err == nil || !strings.Contains(err.Error(), "nil document") {
+		t.Fatalf("expected nil document error, got %v", err)
+	}
+}
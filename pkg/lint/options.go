@@ -0,0 +1,140 @@
+// file: pkg/lint/options.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package lint
+
+import (
+	"time"
+
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+)
+
+// Option configures a Linter. Options are applied in order.
+type Option func(*options) error
+
+// options collects the settings applied by Option values.
+type options struct {
+	cfg         *Config
+	sel         engine.Selection
+	custom      []Rule
+	target      string
+	contextDir  string
+	concurrency int
+	ruleTimeout time.Duration
+}
+
+// WithConfig applies cfg, including its enable, disable and ignored rule lists.
+func WithConfig(cfg *Config) Option {
+	return func(o *options) error {
+		o.cfg = cfg
+		return nil
+	}
+}
+
+// WithConfigFile loads the configuration at path and applies it as WithConfig does.
+func WithConfigFile(path string) Option {
+	return func(o *options) error {
+		cfg, err := LoadConfig(path)
+		if err != nil {
+			return err
+		}
+		o.cfg = cfg
+		return nil
+	}
+}
+
+// WithRules restricts linting to the rules matching the given ID patterns,
+// such as DL3007 or DL30*, opt-in rules included.
+//
+// Repeated use widens the selection. New fails if a pattern matches no
+// available rule.
+func WithRules(patterns ...string) Option {
+	return func(o *options) error {
+		o.sel.Only = append(o.sel.Only, patterns...)
+		return nil
+	}
+}
+
+// WithEnabledRules runs the opt-in rules matching the given ID patterns in
+// addition to the default rules. New fails if a pattern matches no available rule.
+func WithEnabledRules(patterns ...string) Option {
+	return func(o *options) error {
+		o.sel.Enable = append(o.sel.Enable, patterns...)
+		return nil
+	}
+}
+
+// WithoutRules disables the rules matching the given ID patterns.
+func WithoutRules(patterns ...string) Option {
+	return func(o *options) error {
+		o.sel.Disable = append(o.sel.Disable, patterns...)
+		return nil
+	}
+}
+
+// WithCategories restricts linting to rules in the given categories.
+//
+// Repeated use widens the selection. Rules without a category are not run.
+func WithCategories(categories ...Category) Option {
+	return func(o *options) error {
+		for _, c := range categories {
+			o.sel.Categories = append(o.sel.Categories, engine.Category(c))
+		}
+		return nil
+	}
+}
+
+// WithoutCategories disables the rules in the given categories.
+func WithoutCategories(categories ...Category) Option {
+	return func(o *options) error {
+		for _, c := range categories {
+			o.sel.ExcludeCategories = append(o.sel.ExcludeCategories, engine.Category(c))
+		}
+		return nil
+	}
+}
+
+// WithCustomRules adds rules that run after the built-in ones.
+//
+// Custom rules run by default unless they are Describers marking themselves
+// opt-in, and are subject to the same selection as built-in rules; New fails
+// if a rule ID is already in use.
+func WithCustomRules(rules ...Rule) Option {
+	return func(o *options) error {
+		o.custom = append(o.custom, rules...)
+		return nil
+	}
+}
+
+// WithTarget lints the named stage as the build target instead of the final stage.
+func WithTarget(stage string) Option {
+	return func(o *options) error {
+		o.target = stage
+		return nil
+	}
+}
+
+// WithBuildContext lints files read by LintFile against the build context
+// dir instead of the Dockerfile's directory. The rules then also check that
+// COPY and ADD sources exist in dir and are not excluded by its .dockerignore.
+func WithBuildContext(dir string) Option {
+	return func(o *options) error {
+		o.contextDir = dir
+		return nil
+	}
+}
+
+// WithRuleConcurrency runs up to n rules at once against a document.
+func WithRuleConcurrency(n int) Option {
+	return func(o *options) error {
+		o.concurrency = n
+		return nil
+	}
+}
+
+// WithRuleTimeout bounds the time a single rule may spend checking a document.
+func WithRuleTimeout(d time.Duration) Option {
+	return func(o *options) error {
+		o.ruleTimeout = d
+		return nil
+	}
+}
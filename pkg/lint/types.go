@@ -0,0 +1,212 @@
+// file: pkg/lint/types.go
+// (c) 2025 Asymmetric Effort, LLC. scaldwell@asymmetric-effort.com
+package lint
+
+import (
+	"context"
+
+	"github.com/asymmetric-effort/docker-lint/internal/config"
+	"github.com/asymmetric-effort/docker-lint/internal/engine"
+	"github.com/asymmetric-effort/docker-lint/internal/ir"
+)
+
+// Finding is a single lint result.
+type Finding struct {
+	RuleID  string `json:"rule"`
+	Message string `json:"message"`
+	Line    int    `json:"line,omitempty"`
+	// Severity is set by rules with a configured severity, such as custom rules.
+	Severity string `json:"severity,omitempty"`
+}
+
+// Rule is a lint check evaluated against a parsed Dockerfile.
+//
+// Check must be safe for concurrent use when the Linter runs rules
+// concurrently or lints several files at once.
+type Rule interface {
+	ID() string
+	Check(ctx context.Context, d *Document) ([]Finding, error)
+}
+
+// Category groups rules by the concern they address.
+type Category string
+
+// Rule categories.
+const (
+	CategorySecurity          Category = "security"
+	CategoryMaintainability   Category = "maintainability"
+	CategoryPerformance       Category = "performance"
+	CategoryPackageManagement Category = "package-management"
+	CategoryLabels            Category = "labels"
+	CategoryMultiStage        Category = "multi-stage"
+)
+
+// Metadata describes a rule. Rules may provide it by implementing Describer.
+//
+// Fixable reports whether violations can be corrected mechanically. OptIn
+// marks rules that only run when enabled explicitly.
+type Metadata struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description,omitempty"`
+	Category    Category `json:"category,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	Severity    string   `json:"severity"`
+	Fixable     bool     `json:"fixable"`
+	OptIn       bool     `json:"optIn"`
+	DocsURL     string   `json:"docsUrl,omitempty"`
+}
+
+// Describer is implemented by rules that provide Metadata.
+type Describer interface {
+	Metadata() Metadata
+}
+
+// Describe returns the metadata of r, with defaults for rules that are not
+// Describers: the title is the rule ID and the severity is warning.
+func Describe(r Rule) Metadata { return metadataOf(engine.Describe(adaptRule(r))) }
+
+// Config holds docker-lint configuration, as read from .docker-lint.yaml.
+//
+// Custom rules, policies and plugins can only be declared in a configuration
+// file; LoadConfig keeps them along with the fields below.
+type Config struct {
+	// Ignored lists rule IDs that are skipped, like Disable.
+	Ignored []string
+	// Enable lists rule ID patterns, such as DL30*, of opt-in rules to run.
+	Enable []string
+	// Disable lists rule ID patterns of rules that never run.
+	Disable []string
+	// Override remaps rule IDs to a severity level, keyed by that level.
+	Override map[string][]string
+	// FailureThreshold is the minimum severity that causes a failure.
+	FailureThreshold string
+	// TrustedRegistries lists registries considered secure for FROM instructions.
+	TrustedRegistries []string
+	// AllowedPorts lists the ports and ranges the final image may expose;
+	// empty allows every port.
+	AllowedPorts []string
+	// DeniedPorts lists the ports and ranges the final image must not expose.
+	DeniedPorts []string
+	// StrictLabels enforces LabelSchema.
+	StrictLabels bool
+	// LabelSchema maps required label keys to a description or type.
+	LabelSchema map[string]string
+
+	// file is the configuration read by LoadConfig, holding the declarations
+	// that have no counterpart above.
+	file *config.Config
+}
+
+// LoadConfig reads a configuration file.
+func LoadConfig(path string) (*Config, error) {
+	c, err := config.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Config{
+		Ignored:           c.Ignored,
+		Enable:            c.Enable,
+		Disable:           c.Disable,
+		Override:          c.Override,
+		FailureThreshold:  c.FailureThreshold,
+		TrustedRegistries: c.TrustedRegistries,
+		AllowedPorts:      c.AllowedPorts,
+		DeniedPorts:       c.DeniedPorts,
+		StrictLabels:      c.StrictLabels,
+		LabelSchema:       c.LabelSchema,
+		file:              c,
+	}, nil
+}
+
+// internal converts c to the configuration the rules read; nil stays nil.
+func (c *Config) internal() *config.Config {
+	if c == nil {
+		return nil
+	}
+	out := config.Config{}
+	if c.file != nil {
+		out = *c.file
+	}
+	out.Ignored = c.Ignored
+	out.Enable = c.Enable
+	out.Disable = c.Disable
+	out.Override = c.Override
+	out.FailureThreshold = c.FailureThreshold
+	out.TrustedRegistries = c.TrustedRegistries
+	out.AllowedPorts = c.AllowedPorts
+	out.DeniedPorts = c.DeniedPorts
+	out.StrictLabels = c.StrictLabels
+	out.LabelSchema = c.LabelSchema
+	return &out
+}
+
+// findingsOf converts engine findings to Findings.
+func findingsOf(fnds []engine.Finding) []Finding {
+	if fnds == nil {
+		return nil
+	}
+	out := make([]Finding, len(fnds))
+	for i, f := range fnds {
+		out[i] = Finding{RuleID: f.RuleID, Message: f.Message, Line: f.Line, Severity: f.Severity}
+	}
+	return out
+}
+
+// metadataOf converts engine metadata to Metadata.
+func metadataOf(m engine.Metadata) Metadata {
+	return Metadata{
+		Title:       m.Title,
+		Description: m.Description,
+		Category:    Category(m.Category),
+		Tags:        m.Tags,
+		Severity:    m.Severity,
+		Fixable:     m.Fixable,
+		OptIn:       m.OptIn,
+		DocsURL:     m.DocsURL,
+	}
+}
+
+// customRule runs a Rule in the engine.
+type customRule struct{ r Rule }
+
+// describedRule runs a Rule that is also a Describer in the engine.
+type describedRule struct{ customRule }
+
+// adaptRule wraps r for the engine, keeping its metadata when it has any.
+func adaptRule(r Rule) engine.Rule {
+	if _, ok := r.(Describer); ok {
+		return describedRule{customRule{r}}
+	}
+	return customRule{r}
+}
+
+// ID returns the identifier of the wrapped rule.
+func (c customRule) ID() string { return c.r.ID() }
+
+// Check runs the wrapped rule against d.
+func (c customRule) Check(ctx context.Context, d *ir.Document) ([]engine.Finding, error) {
+	fnds, err := c.r.Check(ctx, newDocument(d))
+	if fnds == nil {
+		return nil, err
+	}
+	out := make([]engine.Finding, len(fnds))
+	for i, f := range fnds {
+		out[i] = engine.Finding{RuleID: f.RuleID, Message: f.Message, Line: f.Line, Severity: f.Severity}
+	}
+	return out, err
+}
+
+// Metadata converts the metadata of the wrapped rule.
+func (c describedRule) Metadata() engine.Metadata {
+	m := c.r.(Describer).Metadata()
+	return engine.Metadata{
+		Title:       m.Title,
+		Description: m.Description,
+		Category:    engine.Category(m.Category),
+		Tags:        m.Tags,
+		Severity:    m.Severity,
+		Fixable:     m.Fixable,
+		OptIn:       m.OptIn,
+		DocsURL:     m.DocsURL,
+	}
+}